@@ -0,0 +1,526 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/server"
+	"github.com/apodacaa/amos/internal/storage"
+	"github.com/google/uuid"
+	"golang.org/x/term"
+)
+
+// usage is printed for unknown subcommands and "amos help"
+const usage = `Usage: amos [--view dashboard|agenda|todos|board]
+       amos <command> [flags]
+
+Without a command, amos starts the interactive journal.
+--view picks the screen it opens on (default dashboard).
+
+Commands:
+  review [--week 2026-W42] [--save] [--private]
+                                      Print the weekly review as Markdown
+  timesheet [--week 2026-W42] [--save] [--private]
+                                      Print tracked time by tag as CSV (hours)
+  serve [--addr 127.0.0.1:7373 | --socket path] [--token t]
+                                      Serve entries and todos as a local JSON API
+  sync [--init] [--remote url]        Version ~/.amos with git and sync it with a remote
+  rekey [--decrypt]                   Encrypt the journal with a passphrase, change it, or
+                                      turn encryption off
+  backup [list | now | restore <snapshot>]
+                                      List, take or restore backups (taken hourly on save)
+  fsck [--fix]                        Check entries and todos for broken links, duplicate IDs,
+                                      bad statuses and tags; --fix repairs them after a backup
+  help                                Show this help
+
+An encrypted journal asks for its passphrase (or reads $AMOS_PASSPHRASE).
+@private entries (and their todos) are left out of exports unless --private.
+`
+
+// startViews are the screens the TUI can open on (amos --view agenda)
+var startViews = []string{"dashboard", "agenda", "todos", "board"}
+
+// parseStartFlags parses flags for the interactive journal and returns the start view
+func parseStartFlags(args []string) (string, error) {
+	fs := flag.NewFlagSet("amos", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), usage) }
+	view := fs.String("view", "dashboard", "screen to open on: dashboard, agenda, todos or board")
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() > 0 {
+		return "", fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	for _, v := range startViews {
+		if *view == v {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("unknown view %q (want dashboard, agenda, todos or board)", *view)
+}
+
+// passphraseEnv lets scripts (and "amos serve" under a service manager) unlock without a prompt
+const passphraseEnv = "AMOS_PASSPHRASE"
+
+// runCommand dispatches a CLI subcommand and returns the process exit code
+func runCommand(args []string) int {
+	// Every command but help reads the data files
+	switch args[0] {
+	case "review", "timesheet", "serve", "sync", "rekey", "backup", "fsck":
+		if err := unlockJournal(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	switch args[0] {
+	case "review":
+		return runReview(args[1:])
+	case "timesheet":
+		return runTimesheet(args[1:])
+	case "serve":
+		return runServe(args[1:])
+	case "sync":
+		return runSync(args[1:])
+	case "rekey":
+		return runRekey(args[1:])
+	case "backup":
+		return runBackup(args[1:])
+	case "fsck":
+		return runFsck(args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+// runReview prints (and optionally saves) the Markdown weekly review
+func runReview(args []string) int {
+	fs := flag.NewFlagSet("review", flag.ContinueOnError)
+	currentYear, currentWeek := helpers.GetISOWeek(time.Now())
+	weekFlag := fs.String("week", helpers.WeekID(currentYear, currentWeek), "ISO week to review, e.g. 2026-W42")
+	save := fs.Bool("save", false, "also write the review to ~/.amos/reviews/<week>.md")
+	private := fs.Bool("private", false, "include @private entries and their todos")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	year, week, err := helpers.ParseISOWeek(*weekFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	entries, err := storage.LoadEntries()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading entries: %v\n", err)
+		return 1
+	}
+	todos, err := storage.LoadTodos()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading todos: %v\n", err)
+		return 1
+	}
+
+	// Completed work may already be archived
+	archived, err := storage.LoadArchivedTodos()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading archive: %v\n", err)
+		return 1
+	}
+	todos = append(todos, archived...)
+
+	if !*private {
+		todos = helpers.WithoutPrivateTodos(todos, entries)
+		entries = helpers.WithoutPrivateEntries(entries)
+	}
+
+	markdown := helpers.FormatReviewMarkdown(helpers.BuildWeeklyReview(entries, todos, year, week, time.Local))
+	fmt.Print(markdown)
+
+	if *save {
+		path, err := storage.SaveReview(helpers.WeekID(year, week), markdown)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving review: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Saved %s\n", path)
+	}
+
+	return 0
+}
+
+// runTimesheet prints (and optionally saves) the CSV timesheet for one ISO week
+func runTimesheet(args []string) int {
+	fs := flag.NewFlagSet("timesheet", flag.ContinueOnError)
+	currentYear, currentWeek := helpers.GetISOWeek(time.Now())
+	weekFlag := fs.String("week", helpers.WeekID(currentYear, currentWeek), "ISO week to sum, e.g. 2026-W42")
+	save := fs.Bool("save", false, "also write the timesheet to ~/.amos/timesheets/<week>.csv")
+	private := fs.Bool("private", false, "include time on todos from @private entries")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	year, week, err := helpers.ParseISOWeek(*weekFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	todos, err := storage.LoadTodos()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading todos: %v\n", err)
+		return 1
+	}
+
+	// Tracked time may be on archived todos
+	archived, err := storage.LoadArchivedTodos()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading archive: %v\n", err)
+		return 1
+	}
+	todos = append(todos, archived...)
+
+	// Todos link to their entry by ID: entries are only needed to know which are private
+	if !*private {
+		entries, err := storage.LoadEntries()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading entries: %v\n", err)
+			return 1
+		}
+		todos = helpers.WithoutPrivateTodos(todos, entries)
+	}
+
+	csv := helpers.FormatTimesheetCSV(helpers.BuildTimesheet(todos, year, week, time.Local, time.Now()))
+	fmt.Print(csv)
+
+	if *save {
+		path, err := storage.SaveTimesheet(helpers.WeekID(year, week), csv)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving timesheet: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Saved %s\n", path)
+	}
+
+	return 0
+}
+
+// runServe serves the local HTTP JSON API until interrupted
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", server.DefaultAddr, "loopback address to listen on")
+	socket := fs.String("socket", "", "listen on this Unix socket instead of TCP")
+	token := fs.String("token", "", "bearer token clients must send (default: generate one into ~/.amos/api.token)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	listener, err := server.Listen(*addr, *socket)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	defer listener.Close()
+
+	if *token == "" {
+		generated, err := server.GenerateToken()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating token: %v\n", err)
+			return 1
+		}
+		path, err := storage.SaveAPIToken(generated)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving token: %v\n", err)
+			return 1
+		}
+		*token = generated
+		fmt.Fprintf(os.Stderr, "Token written to %s\n", path)
+	}
+
+	fmt.Fprintf(os.Stderr, "Serving on %s (Ctrl+C to stop)\n", listener.Addr())
+
+	// Shut down cleanly on Ctrl+C so the socket file is removed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{Handler: server.New(*token).Handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runSync turns on git versioning (--init) and syncs the data directory with the configured remote
+func runSync(args []string) int {
+	fs := flag.NewFlagSet("sync", flag.ContinueOnError)
+	initRepo := fs.Bool("init", false, "make ~/.amos a git repository (every save becomes a commit)")
+	remote := fs.String("remote", "", "git remote to sync with, saved to config.json as sync_remote")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *initRepo {
+		if err := storage.InitVersioning(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintln(os.Stderr, "Versioning ~/.amos with git (every save is a commit)")
+	}
+
+	cfg, err := storage.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		return 1
+	}
+	if *remote != "" && *remote != cfg.SyncRemote {
+		cfg.SyncRemote = *remote
+		if err := storage.SaveConfig(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+			return 1
+		}
+	}
+	if cfg.SyncRemote == "" {
+		if *initRepo {
+			return 0 // Versioning only; sync once a remote is set
+		}
+		fmt.Fprintln(os.Stderr, "Error: no remote configured (amos sync --remote <url>)")
+		return 2
+	}
+
+	result, err := storage.Sync(cfg.SyncRemote)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var done []string
+	if result.Pulled {
+		done = append(done, "pulled")
+	}
+	if result.Pushed {
+		done = append(done, "pushed")
+	}
+	if len(done) == 0 {
+		done = append(done, "already up to date")
+	}
+	fmt.Fprintf(os.Stderr, "Synced with %s: %s\n", cfg.SyncRemote, strings.Join(done, ", "))
+	if result.Conflicts > 0 {
+		fmt.Fprintf(os.Stderr, "%d items changed on both sides: kept the most recent version\n", result.Conflicts)
+	}
+	return 0
+}
+
+// unlockJournal unlocks an encrypted journal with $AMOS_PASSPHRASE or a prompt (no-op when plain text)
+func unlockJournal() error {
+	if !storage.IsEncrypted() {
+		return nil
+	}
+	if passphrase, ok := os.LookupEnv(passphraseEnv); ok {
+		return storage.Unlock(passphrase)
+	}
+	passphrase, err := readPassphrase("Passphrase: ")
+	if err != nil {
+		return err
+	}
+	return storage.Unlock(passphrase)
+}
+
+// readPassphrase prompts on stderr and reads a line from the terminal without echoing it
+func readPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("journal is encrypted: run in a terminal or set %s", passphraseEnv)
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	return string(passphrase), err
+}
+
+// runRekey encrypts the journal, changes its passphrase, or (--decrypt) turns encryption off
+func runRekey(args []string) int {
+	fs := flag.NewFlagSet("rekey", flag.ContinueOnError)
+	decrypt := fs.Bool("decrypt", false, "turn encryption off (store plain JSON again)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *decrypt {
+		if !storage.IsEncrypted() {
+			fmt.Fprintln(os.Stderr, "The journal is not encrypted")
+			return 0
+		}
+		if err := storage.Rekey(""); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintln(os.Stderr, "Encryption turned off: data files and backups are plain JSON")
+		return 0
+	}
+
+	passphrase, err := readPassphrase("New passphrase: ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if passphrase == "" {
+		fmt.Fprintln(os.Stderr, "Error: passphrase cannot be empty (use --decrypt to turn encryption off)")
+		return 2
+	}
+	confirm, err := readPassphrase("Repeat passphrase: ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if confirm != passphrase {
+		fmt.Fprintln(os.Stderr, "Error: passphrases don't match")
+		return 2
+	}
+
+	wasEncrypted := storage.IsEncrypted()
+	if err := storage.Rekey(passphrase); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if wasEncrypted {
+		fmt.Fprintln(os.Stderr, "Passphrase changed: data files and backups re-encrypted")
+	} else {
+		fmt.Fprintln(os.Stderr, "Journal and backups encrypted: amos will ask for the passphrase on start")
+	}
+	if storage.IsVersioned() {
+		// Rekey only rewrites the current files, not the commits before it
+		if wasEncrypted {
+			fmt.Fprintln(os.Stderr, "⚠ Earlier commits in ~/.amos/.git still open with the old passphrase")
+		} else {
+			fmt.Fprintln(os.Stderr, "⚠ Earlier commits in ~/.amos/.git (and any sync remote) still hold the journal in plain text")
+		}
+		fmt.Fprintln(os.Stderr, "  To drop that history: rm -rf ~/.amos/.git && amos sync --init (and recreate the remote)")
+	}
+	return 0
+}
+
+// runBackup lists snapshots (default), takes one now, or restores one
+func runBackup(args []string) int {
+	action := "list"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "list":
+		snapshots, err := storage.ListSnapshots()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		if len(snapshots) == 0 {
+			fmt.Fprintln(os.Stderr, "No backups yet (one is taken before the first save of each hour)")
+			return 0
+		}
+		for _, snapshot := range snapshots {
+			counts, err := storage.CountSnapshot(snapshot.Name)
+			if err != nil {
+				fmt.Printf("%s  (unreadable: %v)\n", snapshot.Name, err)
+				continue
+			}
+			fmt.Printf("%s  %d entries, %d todos, %d archived, %d trashed\n",
+				snapshot.Name, counts.Entries, counts.Todos, counts.Archived, counts.Trashed)
+		}
+		return 0
+	case "now":
+		snapshot, err := storage.CreateSnapshot()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		if snapshot.Name == "" {
+			fmt.Fprintln(os.Stderr, "Nothing to back up yet")
+			return 0
+		}
+		fmt.Fprintf(os.Stderr, "Backed up to %s\n", snapshot.Name)
+		return 0
+	case "restore":
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, "Error: usage: amos backup restore <snapshot> (see amos backup list)")
+			return 2
+		}
+		if err := storage.RestoreSnapshot(args[1]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Restored %s (the replaced files were backed up first)\n", args[1])
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown backup action %q (want list, now or restore)\n", action)
+		return 2
+	}
+}
+
+// runFsck reports integrity problems in the data files (exit 1 if any), repairing them with --fix
+func runFsck(args []string) int {
+	fs := flag.NewFlagSet("fsck", flag.ContinueOnError)
+	fix := fs.Bool("fix", false, "repair the problems found (the data files are backed up first)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	files, err := storage.LoadDataFiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	report := helpers.CheckIntegrity(files.Entries, files.Todos, files.Archived, files.Trashed)
+	fmt.Print(helpers.FormatIntegrityReport(report))
+	if len(report.Problems) == 0 {
+		return 0
+	}
+	if !*fix {
+		fmt.Fprintln(os.Stderr, "Run amos fsck --fix to repair (the data files are backed up first)")
+		return 1
+	}
+
+	snapshot, err := storage.RepairDataFiles(func(files *storage.DataFiles) {
+		files.Entries, files.Todos, files.Archived, files.Trashed = helpers.RepairIntegrity(
+			files.Entries, files.Todos, files.Archived, files.Trashed, func() string { return uuid.New().String() })
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	// Check again: what's left couldn't be repaired
+	files, err = storage.LoadDataFiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	left := helpers.CheckIntegrity(files.Entries, files.Todos, files.Archived, files.Trashed)
+	fmt.Fprintf(os.Stderr, "Repaired %d problems (undo with amos backup restore %s)\n", len(report.Problems)-len(left.Problems), snapshot.Name)
+	if len(left.Problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d problems left\n", len(left.Problems))
+		return 1
+	}
+	return 0
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/apodacaa/amos/internal/helpers"
@@ -26,10 +27,249 @@ func (m Model) loadEntries() tea.Cmd {
 }
 
 // loadTodos loads all todos from storage (async)
+// Done todos past the configured age are moved to archive.json first, and old trash is purged
 func (m Model) loadTodos() tea.Cmd {
 	return func() tea.Msg {
+		now := time.Now()
+		archived, err := autoArchiveDoneTodos(now)
+		if err != nil {
+			return todosLoadedMsg{err: err}
+		}
+		purged, err := purgeOldTrash(now)
+		if err != nil {
+			return todosLoadedMsg{err: err}
+		}
 		todos, err := storage.LoadTodos()
-		return todosLoadedMsg{todos: todos, err: err}
+		return todosLoadedMsg{todos: todos, autoArchived: archived, purged: purged, err: err}
+	}
+}
+
+// waitForDataChange waits for the next change another process makes to entries.json or todos.json
+func waitForDataChange(w *storage.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		change, ok := <-w.Changes()
+		if !ok {
+			return nil // Watcher closed
+		}
+		return dataChangedMsg{change: change}
+	}
+}
+
+// reloadChangedData reloads the files another process changed
+// (no auto-archive or trash purge here: those run on the regular loads)
+func reloadChangedData(change storage.Change) tea.Cmd {
+	var cmds []tea.Cmd
+	if change.Entries {
+		cmds = append(cmds, func() tea.Msg {
+			entries, err := storage.LoadEntries()
+			return entriesLoadedMsg{entries: entries, external: true, err: err}
+		})
+	}
+	if change.Todos {
+		cmds = append(cmds, func() tea.Msg {
+			todos, err := storage.LoadTodos()
+			return todosLoadedMsg{todos: todos, external: true, err: err}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// autoArchiveDoneTodos moves done todos older than archive_done_after_days (config.json) to the archive
+// Reads config itself: loadTodos runs at startup before the model has its config
+func autoArchiveDoneTodos(now time.Time) (int, error) {
+	cfg, err := storage.LoadConfig()
+	if err != nil {
+		return 0, err
+	}
+	todos, err := storage.LoadTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	ids := helpers.DoneTodosToArchive(todos, helpers.ArchiveDoneAfterDays(cfg), now)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return storage.ArchiveTodos(ids, now)
+}
+
+// purgeOldTrash permanently removes todos deleted more than purge_trash_after_days (config.json) ago
+func purgeOldTrash(now time.Time) (int, error) {
+	cfg, err := storage.LoadConfig()
+	if err != nil {
+		return 0, err
+	}
+	trashed, err := storage.LoadTrashedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	ids := helpers.TrashedTodosToPurge(trashed, helpers.PurgeTrashAfterDays(cfg), now)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return storage.PurgeTrashedTodos(ids)
+}
+
+// loadArchive loads archived todos (archive.json is only read on demand)
+func (m Model) loadArchive() tea.Cmd {
+	return func() tea.Msg {
+		todos, err := storage.LoadArchivedTodos()
+		return archiveLoadedMsg{todos: todos, err: err}
+	}
+}
+
+// restoreTodos moves archived todos back to todos.json (undoable)
+func (m Model) restoreTodos(ids map[string]bool) tea.Cmd {
+	return func() tea.Msg {
+		now := time.Now()
+
+		archived, err := storage.LoadArchivedTodos()
+		if err != nil {
+			return todosRestoredMsg{err: err}
+		}
+
+		restored, err := storage.RestoreTodos(ids, now)
+		if err != nil {
+			return todosRestoredMsg{err: err}
+		}
+
+		// Undo record: archived version before, restored version after
+		record := models.UndoRecord{Label: fmt.Sprintf("restore %d todos", restored), At: now}
+		for _, todo := range archived {
+			if ids[todo.ID] {
+				before, after := todo, todo
+				after.ArchivedAt = nil
+				after.RestoredAt = &now
+				record.Todos = append(record.Todos, models.TodoChange{ID: todo.ID, Before: &before, After: &after, BeforeArchived: true})
+				if restored == 1 {
+					record.Label = "restore \"" + todo.Title + "\""
+				}
+			}
+		}
+		return todosRestoredMsg{restored: restored, undo: &record}
+	}
+}
+
+// loadTrash loads deleted todos (trash.json is only read on demand)
+func (m Model) loadTrash() tea.Cmd {
+	return func() tea.Msg {
+		todos, err := storage.LoadTrashedTodos()
+		return trashLoadedMsg{todos: todos, err: err}
+	}
+}
+
+// restoreTrashedTodos moves deleted todos back to todos.json (undoable)
+func (m Model) restoreTrashedTodos(ids map[string]bool) tea.Cmd {
+	return func() tea.Msg {
+		trashed, err := storage.LoadTrashedTodos()
+		if err != nil {
+			return todosRestoredMsg{fromTrash: true, err: err}
+		}
+
+		restored, err := storage.RestoreTrashedTodos(ids)
+		if err != nil {
+			return todosRestoredMsg{fromTrash: true, err: err}
+		}
+
+		// Undo record: deleted version before, restored version after
+		record := models.UndoRecord{Label: fmt.Sprintf("restore %d todos", restored), At: time.Now()}
+		for _, todo := range trashed {
+			if ids[todo.ID] {
+				before, after := todo, todo
+				after.DeletedAt = nil
+				record.Todos = append(record.Todos, models.TodoChange{ID: todo.ID, Before: &before, After: &after, BeforeTrashed: true})
+				if restored == 1 {
+					record.Label = "restore \"" + todo.Title + "\""
+				}
+			}
+		}
+		return todosRestoredMsg{restored: restored, fromTrash: true, undo: &record}
+	}
+}
+
+// checkIntegrity counts problems amos fsck would report (all four data files, for the dashboard badge)
+func (m Model) checkIntegrity() tea.Cmd {
+	return func() tea.Msg {
+		files, err := storage.LoadDataFiles()
+		if err != nil {
+			return integrityCheckedMsg{err: err}
+		}
+		report := helpers.CheckIntegrity(files.Entries, files.Todos, files.Archived, files.Trashed)
+		return integrityCheckedMsg{problems: len(report.Problems)}
+	}
+}
+
+// loadBackups lists the backup snapshots with their entry and todo counts (for the restore preview)
+func (m Model) loadBackups() tea.Cmd {
+	return func() tea.Msg {
+		snapshots, err := storage.ListSnapshots()
+		if err != nil {
+			return backupsLoadedMsg{err: err}
+		}
+		counts := map[string]storage.SnapshotCounts{}
+		for _, snapshot := range snapshots {
+			if c, err := storage.CountSnapshot(snapshot.Name); err == nil {
+				counts[snapshot.Name] = c
+			}
+		}
+		return backupsLoadedMsg{snapshots: snapshots, counts: counts}
+	}
+}
+
+// restoreBackup restores a snapshot over the data files (the current ones are backed up first)
+func restoreBackup(name string) tea.Cmd {
+	return func() tea.Msg {
+		return backupRestoredMsg{name: name, err: storage.RestoreSnapshot(name)}
+	}
+}
+
+// unlockWithPassphrase derives the key of an encrypted journal off the UI loop
+func unlockWithPassphrase(passphrase string) tea.Cmd {
+	return func() tea.Msg {
+		return unlockedMsg{err: storage.Unlock(passphrase)}
+	}
+}
+
+// loadConfig loads user settings (tag aliases, etc.) from storage
+func (m Model) loadConfig() tea.Cmd {
+	return func() tea.Msg {
+		cfg, err := storage.LoadConfig()
+		return configLoadedMsg{config: cfg, err: err}
+	}
+}
+
+// loadUndoLog loads the undo/redo history, dropping records outside the session window
+func (m Model) loadUndoLog() tea.Cmd {
+	return func() tea.Msg {
+		log, err := storage.LoadUndoLog()
+		return undoLogLoadedMsg{log: helpers.PruneUndoLog(log, time.Now()), err: err}
+	}
+}
+
+// saveUndoLog writes the undo/redo history so it survives a restart
+func saveUndoLog(log models.UndoLog) tea.Cmd {
+	return func() tea.Msg {
+		return undoLogSavedMsg{err: storage.SaveUndoLog(log)}
+	}
+}
+
+// applyUndo reverts (undo=true) or replays (undo=false) a recorded action in storage
+func (m Model) applyUndo(record models.UndoRecord, undo bool) tea.Cmd {
+	return func() tea.Msg {
+		msg := undoAppliedMsg{record: record, undo: undo}
+
+		// One locked write across every file the record touches
+		msg.err = storage.UpdateDataFiles(func(files *storage.DataFiles) error {
+			if len(record.Todos) > 0 {
+				files.Todos, files.Archived, files.Trashed = helpers.ApplyTodoChanges(files.Todos, files.Archived, files.Trashed, record.Todos, undo)
+			}
+			if len(record.Entries) > 0 {
+				files.Entries = helpers.ApplyEntryChanges(files.Entries, record.Entries, undo)
+			}
+			return nil
+		})
+		return msg
 	}
 }
 
@@ -55,8 +295,8 @@ func (m Model) saveEntry() tea.Cmd {
 		// Parse content into title and body
 		title, body := helpers.ParseEntryContent(content)
 
-		// Extract tags from title and body
-		tags := helpers.ExtractTags(title + " " + body)
+		// Extract tags from title and body (normalized through the alias table)
+		tags := helpers.ApplyTagAliases(helpers.ExtractTags(title+" "+body), m.config.TagAliases)
 
 		// Extract todos from content
 		todoTitles := helpers.ExtractTodos(content)
@@ -64,36 +304,50 @@ func (m Model) saveEntry() tea.Cmd {
 		// Create todo IDs list
 		todoIDs := make([]string, 0, len(todoTitles))
 
-		// Create and save todos
+		// Undo record: previous version of this entry (nil if new) plus created todos
+		record := models.UndoRecord{Label: "save entry", At: time.Now()}
+
+		// Create todos (saved together with the entry)
+		todos := make([]models.Todo, 0, len(todoTitles))
 		for _, todoTitle := range todoTitles {
+			due, scheduled := helpers.ExtractSchedule(todoTitle, time.Now())
 			todo := models.Todo{
 				ID:        uuid.New().String(),
 				Title:     todoTitle,
 				Status:    "open",
-				Tags:      helpers.ExtractTags(todoTitle), // Extract tags from todo title
+				Tags:      helpers.ApplyTagAliases(helpers.ExtractTags(todoTitle), m.config.TagAliases), // Extract tags from todo title
+				People:    helpers.ExtractPeople(todoTitle),
 				CreatedAt: time.Now(),
 				EntryID:   &m.currentEntry.ID, // Link to this entry
+				Due:       due,
+				Scheduled: scheduled,
 			}
 
-			// Save each todo
-			if err := storage.SaveTodo(todo); err != nil {
-				return saveCompleteMsg{err: err}
-			}
-
+			todos = append(todos, todo)
 			todoIDs = append(todoIDs, todo.ID)
+			created := todo
+			record.Todos = append(record.Todos, models.TodoChange{ID: todo.ID, After: &created})
 		}
 
 		// Update current entry
 		m.currentEntry.Title = title
 		m.currentEntry.Body = body
 		m.currentEntry.Tags = tags
+		m.currentEntry.Private = helpers.IsPrivateTagged(tags)
+		m.currentEntry.People = helpers.ExtractPeople(title + "\n" + body)
 		m.currentEntry.TodoIDs = todoIDs
 		m.currentEntry.Timestamp = time.Now()
 
-		// Save entry to storage
-		err := storage.SaveEntry(m.currentEntry)
+		// Save the entry and its todos in one locked write; the replaced version goes in the undo record
+		previous, err := storage.SaveEntryWithTodos(m.currentEntry, todos)
+		if err != nil {
+			return saveCompleteMsg{err: err}
+		}
+		saved := m.currentEntry
+		record.Entries = []models.EntryChange{{ID: saved.ID, Before: previous, After: &saved}}
+		record.Label = "save entry \"" + title + "\""
 
-		return saveCompleteMsg{err: err}
+		return saveCompleteMsg{undo: &record}
 	}
 }
 
@@ -101,8 +355,112 @@ func (m Model) saveEntry() tea.Cmd {
 func (m Model) saveTodo() tea.Cmd {
 	return func() tea.Msg {
 		// Save todo
-		err := storage.SaveTodo(m.currentTodo)
+		if err := storage.SaveTodo(m.currentTodo); err != nil {
+			return saveCompleteMsg{err: err}
+		}
+
+		created := m.currentTodo
+		record := helpers.TodoUndoRecord("add todo \""+created.Title+"\"", nil, &created, time.Now())
+		return saveCompleteMsg{undo: &record}
+	}
+}
+
+// renameTag renames (or merges) oldTag into newTag across all entries and todos (archived and trashed ones too)
+// Rewrites Tags arrays and @mentions, then records oldTag as an alias of newTag
+func (m Model) renameTag(oldTag, newTag string) tea.Cmd {
+	return func() tea.Msg {
+		msg := tagRenamedMsg{oldTag: oldTag, newTag: newTag}
+
+		// Load, rename and save under one lock: a write from another process can't land in between
+		err := storage.UpdateDataFiles(func(files *storage.DataFiles) error {
+			// Merge if the target tag is already in use
+			for _, stats := range helpers.CountTagUsage(files.Entries, files.Todos) {
+				if stats.Tag == "@"+newTag {
+					msg.merged = true
+					break
+				}
+			}
+
+			files.Entries, msg.entriesChanged = helpers.RenameTagInEntries(files.Entries, oldTag, newTag)
+			var archived, trashed int
+			files.Todos, msg.todosChanged = helpers.RenameTagInTodos(files.Todos, oldTag, newTag)
+			files.Archived, archived = helpers.RenameTagInTodos(files.Archived, oldTag, newTag)
+			files.Trashed, trashed = helpers.RenameTagInTodos(files.Trashed, oldTag, newTag)
+			msg.todosChanged += archived + trashed
+			return nil
+		})
+		if err != nil {
+			msg.err = err
+			return msg
+		}
 
-		return saveCompleteMsg{err: err}
+		// Future @oldTag mentions normalize automatically
+		msg.err = storage.SaveTagAlias(oldTag, newTag)
+		return msg
+	}
+}
+
+// saveReview exports the weekly review shown in the TUI to ~/.amos/reviews
+func (m Model) saveReview() tea.Cmd {
+	review := helpers.BuildWeeklyReview(m.overviewEntries(), m.reportTodos(), m.reviewYear, m.reviewWeek, time.Local)
+	return func() tea.Msg {
+		path, err := storage.SaveReview(helpers.WeekID(review.Year, review.Week), helpers.FormatReviewMarkdown(review))
+		return reviewSavedMsg{path: path, err: err}
+	}
+}
+
+// applyBulkAction applies one bulk action to the todos in ids with a single storage write
+// (archive moves them to archive.json instead, delete to trash.json)
+func (m Model) applyBulkAction(ids map[string]bool, action helpers.BulkAction) tea.Cmd {
+	return func() tea.Msg {
+		now := time.Now()
+
+		// Undo record: every todo the action touches, before and after
+		record := models.UndoRecord{At: now}
+		changed := 0
+
+		// Load, change and save under one lock: a write from another process can't land in between
+		err := storage.UpdateDataFiles(func(files *storage.DataFiles) error {
+			if action.Kind == "archive" || action.Kind == "delete" {
+				for _, todo := range files.Todos {
+					if !ids[todo.ID] {
+						continue
+					}
+					// Moving todos out of todos.json stops their timer first (no interval left open in the archive or trash)
+					if helpers.TimerRunning(todo) {
+						todo = helpers.StopTimer(todo, now)
+					}
+					before, after := todo, todo
+					change := models.TodoChange{ID: todo.ID, Before: &before, After: &after}
+					if action.Kind == "archive" {
+						after.ArchivedAt = &now
+						change.AfterArchived = true
+					} else {
+						after.DeletedAt = &now
+						change.AfterTrashed = true
+					}
+					record.Todos = append(record.Todos, change)
+				}
+				changed = len(record.Todos)
+				files.Todos, files.Archived, files.Trashed = helpers.ApplyTodoChanges(files.Todos, files.Archived, files.Trashed, record.Todos, false)
+				return nil
+			}
+
+			var updated []models.Todo
+			updated, changed = helpers.ApplyBulkAction(files.Todos, ids, action, now)
+			for i := range files.Todos {
+				if ids[files.Todos[i].ID] {
+					before, after := files.Todos[i], updated[i]
+					record.Todos = append(record.Todos, models.TodoChange{ID: before.ID, Before: &before, After: &after})
+				}
+			}
+			files.Todos = updated
+			return nil
+		})
+		if err != nil {
+			return bulkAppliedMsg{err: err}
+		}
+		record.Label = helpers.DescribeBulkResult(action, changed, changed)
+		return bulkAppliedMsg{summary: helpers.DescribeBulkResult(action, changed, len(ids)), undo: &record}
 	}
 }
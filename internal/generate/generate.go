@@ -0,0 +1,300 @@
+// Package generate builds synthetic journals for performance testing and demos.
+// Output is deterministic: the same Options (seed included) always give the same entries and todos,
+// shaped like real use: weekday bursts, vacations, !todo lines linked to their entry,
+// age-dependent todo statuses and hierarchical tags with a Zipf (few common, many rare) distribution.
+package generate
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/google/uuid"
+)
+
+// linkedShare is the share of todos written as !todo lines in an entry (the rest are standalone)
+const linkedShare = 0.7
+
+// Options configures a generated journal
+type Options struct {
+	Seed      int64     `json:"seed"`
+	Entries   int       `json:"entries"`
+	Todos     int       `json:"todos"`
+	Days      int       `json:"days"`      // Time span, ending on End
+	End       time.Time `json:"end"`       // Last day with activity (only the date is used)
+	Vacations int       `json:"vacations"` // One- or two-week stretches without any entries
+}
+
+// Journal is a generated set of entries and todos, both oldest first (file order)
+type Journal struct {
+	Entries []models.Entry
+	Todos   []models.Todo
+}
+
+// Tags by rank: the first is the most used (Zipf), children nest with "/" like real tags
+var tagsByRank = []string{
+	"work", "work/meetings", "personal", "project/alpha", "health",
+	"client/acme", "ideas", "work/oncall", "family", "project/alpha/infra",
+	"health/running", "reading", "project/beta", "client/globex", "learning/go",
+	"finance", "home", "travel", "work/hiring", "project/beta/design",
+	"health/sleep", "learning/rust", "client/initech", "garden", "music",
+}
+
+// People by rank (Zipf, like tags)
+var peopleByRank = []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi", "ivan", "judy"}
+
+var entryTitles = []string{
+	"Standup notes", "Planning", "Weekly review", "Retro", "1:1",
+	"Design notes", "Bug hunt", "Reading notes", "Morning pages", "Ideas",
+	"Call notes", "Evening reflection", "Research", "Incident review", "Errands",
+}
+
+var bodyFragments = []string{
+	"Discussed the upcoming features and roadmap.",
+	"Need to prioritize the high-impact items first.",
+	"Agreed on the new architecture approach.",
+	"Several blockers were identified and assigned.",
+	"Reviewed the user feedback and analytics.",
+	"Timeline adjusted based on new requirements.",
+	"Slept badly, slow start.",
+	"Good focus block in the afternoon.",
+	"Performance numbers look promising.",
+	"Wrote down a few loose ends.",
+}
+
+var todoTitles = []string{
+	"Follow up on email", "Review pull request", "Update documentation", "Fix flaky test",
+	"Deploy to staging", "Write test cases", "Schedule meeting", "Send project update",
+	"Research new library", "Refactor component", "Book appointment", "Pay invoice",
+}
+
+// Generate builds a journal from opts (deterministic for the same opts)
+func Generate(opts Options) Journal {
+	rng := rand.New(rand.NewSource(opts.Seed))
+	end := time.Date(opts.End.Year(), opts.End.Month(), opts.End.Day(), 0, 0, 0, 0, opts.End.Location())
+	days := activityDays(rng, end, opts.Days, opts.Vacations)
+	tagRank := rand.NewZipf(rng, 1.3, 1, uint64(len(tagsByRank)-1))
+	personRank := rand.NewZipf(rng, 1.5, 1, uint64(len(peopleByRank)-1))
+
+	// Timestamps first (sorted, so entries come out oldest first like a real entries.json)
+	stamps := make([]time.Time, opts.Entries)
+	for i := range stamps {
+		stamps[i] = entryTime(rng, pickDay(rng, days))
+	}
+	sort.Slice(stamps, func(i, j int) bool { return stamps[i].Before(stamps[j]) })
+
+	// How many !todo lines each entry gets (recent entries are as likely as old ones)
+	linked := int(float64(opts.Todos) * linkedShare)
+	if opts.Entries == 0 {
+		linked = 0
+	}
+	todoCounts := make([]int, opts.Entries)
+	for i := 0; i < linked; i++ {
+		todoCounts[rng.Intn(opts.Entries)]++
+	}
+
+	journal := Journal{Entries: make([]models.Entry, 0, opts.Entries), Todos: make([]models.Todo, 0, opts.Todos)}
+	for i, stamp := range stamps {
+		entry := models.Entry{ID: newID(rng), Timestamp: stamp}
+
+		var lines []string
+		for j := 0; j < todoCounts[i]; j++ {
+			title := todoTitle(rng, tagRank, personRank, stamp, end)
+			todo := newTodo(rng, title, stamp, end)
+			todo.EntryID = &entry.ID
+			entry.TodoIDs = append(entry.TodoIDs, todo.ID)
+			journal.Todos = append(journal.Todos, todo)
+			lines = append(lines, "!todo "+title)
+		}
+
+		entry.Title = entryTitles[rng.Intn(len(entryTitles))]
+		entry.Body = entryBody(rng, tagRank, personRank, lines)
+		entry.Tags = extractTags(entry.Title + " " + entry.Body)
+		entry.People = helpers.ExtractPeople(entry.Title + "\n" + entry.Body)
+		entry.Private = helpers.IsPrivateTagged(entry.Tags)
+		journal.Entries = append(journal.Entries, entry)
+	}
+
+	// Standalone todos (added from the todo list), created on active days too
+	var standalone []models.Todo
+	for i := len(journal.Todos); i < opts.Todos; i++ {
+		created := entryTime(rng, pickDay(rng, days))
+		standalone = append(standalone, newTodo(rng, todoTitle(rng, tagRank, personRank, created, end), created, end))
+	}
+	journal.Todos = append(journal.Todos, standalone...)
+	sort.SliceStable(journal.Todos, func(i, j int) bool { return journal.Todos[i].CreatedAt.Before(journal.Todos[j].CreatedAt) })
+
+	return journal
+}
+
+// activeDay is one calendar day and how likely an entry is on it (0 = vacation)
+type activeDay struct {
+	date   time.Time
+	weight float64
+}
+
+// activityDays returns a weight per day for the span ending on end (oldest first)
+// Weekdays outweigh weekends, some weeks are bursts (twice as busy) and vacations are silent
+func activityDays(rng *rand.Rand, end time.Time, days, vacations int) []activeDay {
+	if days < 1 {
+		days = 1
+	}
+	result := make([]activeDay, days)
+	weekBoost := map[int]float64{}
+	for i := range result {
+		date := end.AddDate(0, 0, i-days+1)
+		week := i / 7
+		if _, ok := weekBoost[week]; !ok {
+			weekBoost[week] = 1
+			if rng.Float64() < 0.15 {
+				weekBoost[week] = 2
+			}
+		}
+		weight := 1.0
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			weight = 0.3
+		}
+		result[i] = activeDay{date: date, weight: weight * weekBoost[week]}
+	}
+
+	for v := 0; v < vacations; v++ {
+		length := 7 * (1 + rng.Intn(2))
+		if length >= days {
+			break
+		}
+		start := rng.Intn(days - length)
+		for i := start; i < start+length; i++ {
+			result[i].weight = 0
+		}
+	}
+	return result
+}
+
+// pickDay draws a day in proportion to its weight (the last day if all weights are 0)
+func pickDay(rng *rand.Rand, days []activeDay) time.Time {
+	total := 0.0
+	for _, day := range days {
+		total += day.weight
+	}
+	if total == 0 {
+		return days[len(days)-1].date
+	}
+	target := rng.Float64() * total
+	for _, day := range days {
+		if target < day.weight {
+			return day.date
+		}
+		target -= day.weight
+	}
+	return days[len(days)-1].date
+}
+
+// entryTime places an entry within its day: working hours on weekdays, anytime awake on weekends
+func entryTime(rng *rand.Rand, day time.Time) time.Time {
+	from, hours := 8, 10
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		from, hours = 9, 14
+	}
+	minutes := from*60 + rng.Intn(hours*60)
+	return day.Add(time.Duration(minutes)*time.Minute + time.Duration(rng.Intn(60))*time.Second)
+}
+
+// entryBody writes a few sentences mentioning tags and people, then the !todo lines
+func entryBody(rng *rand.Rand, tagRank, personRank *rand.Zipf, todoLines []string) string {
+	var sentences []string
+	for _, i := range rng.Perm(len(bodyFragments))[:1+rng.Intn(4)] {
+		sentences = append(sentences, bodyFragments[i])
+	}
+	mentions := pickTags(rng, tagRank, 1+rng.Intn(3))
+	if rng.Float64() < 0.03 {
+		mentions = append(mentions, helpers.PrivateTag)
+	}
+	for i := range mentions {
+		mentions[i] = "@" + mentions[i]
+	}
+	if rng.Float64() < 0.5 {
+		mentions = append(mentions, "+"+peopleByRank[personRank.Uint64()])
+	}
+
+	body := strings.Join(sentences, " ") + "\n" + strings.Join(mentions, " ")
+	if len(todoLines) > 0 {
+		body += "\n\n" + strings.Join(todoLines, "\n")
+	}
+	return body
+}
+
+// todoTitle writes a !todo title, sometimes with a tag, a person or a due date near its creation
+func todoTitle(rng *rand.Rand, tagRank, personRank *rand.Zipf, created, end time.Time) string {
+	title := todoTitles[rng.Intn(len(todoTitles))]
+	if rng.Float64() < 0.6 {
+		title += " @" + tagsByRank[tagRank.Uint64()]
+	}
+	if rng.Float64() < 0.2 {
+		title += " +" + peopleByRank[personRank.Uint64()]
+	}
+	if rng.Float64() < 0.15 {
+		due := created.AddDate(0, 0, 1+rng.Intn(14))
+		title += " due:" + due.Format("2006-01-02")
+	}
+	return title
+}
+
+// newTodo creates a todo the way saving an entry does, with a status that depends on its age:
+// recent todos are mostly open, old ones mostly done (a few stay open forever)
+func newTodo(rng *rand.Rand, title string, created, end time.Time) models.Todo {
+	due, scheduled := helpers.ExtractSchedule(title, created)
+	todo := models.Todo{
+		ID:        newID(rng),
+		Title:     title,
+		Status:    "open",
+		Tags:      extractTags(title),
+		People:    helpers.ExtractPeople(title),
+		CreatedAt: created,
+		Due:       due,
+		Scheduled: scheduled,
+	}
+
+	age := end.Sub(created).Hours() / 24
+	switch doneChance := 0.85 - 0.65*math.Exp(-age/21); {
+	case rng.Float64() < doneChance:
+		completed := created.Add(time.Duration(rng.ExpFloat64() * 3 * float64(24*time.Hour)))
+		if limit := end.Add(24*time.Hour - time.Second); completed.After(limit) {
+			completed = limit
+		}
+		todo.Status = "done"
+		todo.CompletedAt = &completed
+	case rng.Float64() < 0.25:
+		todo.Status = "next"
+	}
+	return todo
+}
+
+// pickTags draws n distinct tags (fewer if the same one keeps coming up)
+func pickTags(rng *rand.Rand, tagRank *rand.Zipf, n int) []string {
+	seen := map[string]bool{}
+	var tags []string
+	for tries := 0; len(tags) < n && tries < n*4; tries++ {
+		tag := tagsByRank[tagRank.Uint64()]
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// extractTags is helpers.ExtractTags in a fixed order (it returns map order, which would break determinism)
+func extractTags(text string) []string {
+	tags := helpers.ExtractTags(text)
+	sort.Strings(tags)
+	return tags
+}
+
+// newID returns a UUID drawn from rng, so IDs repeat with the seed
+func newID(rng *rand.Rand) string {
+	return uuid.Must(uuid.NewRandomFromReader(rng)).String() // rand.Rand reads never fail
+}
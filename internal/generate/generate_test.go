@@ -0,0 +1,168 @@
+package generate
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+)
+
+func testOptions() Options {
+	return Options{Seed: 42, Entries: 2000, Todos: 1000, Days: 365, End: time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC), Vacations: 2}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	a, b := Generate(testOptions()), Generate(testOptions())
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("Same options gave different journals")
+	}
+
+	other := testOptions()
+	other.Seed = 43
+	if reflect.DeepEqual(a.Entries[0], Generate(other).Entries[0]) {
+		t.Error("A different seed gave the same first entry")
+	}
+}
+
+func TestGenerateShape(t *testing.T) {
+	opts := testOptions()
+	journal := Generate(opts)
+	if len(journal.Entries) != opts.Entries || len(journal.Todos) != opts.Todos {
+		t.Fatalf("Got %d entries, %d todos", len(journal.Entries), len(journal.Todos))
+	}
+
+	// A consistent journal, in file order, inside the span
+	if report := helpers.CheckIntegrity(journal.Entries, journal.Todos, nil, nil); len(report.Problems) != 0 {
+		t.Fatalf("Integrity problems:\n%s", helpers.FormatIntegrityReport(report))
+	}
+	first := opts.End.AddDate(0, 0, -opts.Days)
+	for i, entry := range journal.Entries {
+		if i > 0 && entry.Timestamp.Before(journal.Entries[i-1].Timestamp) {
+			t.Fatalf("Entries out of order at %d", i)
+		}
+		if entry.Timestamp.Before(first) || entry.Timestamp.After(opts.End.AddDate(0, 0, 1)) {
+			t.Fatalf("Entry outside the span: %v", entry.Timestamp)
+		}
+	}
+
+	// Linked todos come from the entry's !todo lines, and the entry lists them
+	linked := 0
+	titles := map[string]bool{}
+	for _, entry := range journal.Entries {
+		for _, title := range helpers.ExtractTodos(entry.Body) {
+			titles[entry.ID+"|"+title] = true
+		}
+	}
+	for _, todo := range journal.Todos {
+		if todo.EntryID == nil {
+			continue
+		}
+		linked++
+		if !titles[*todo.EntryID+"|"+todo.Title] {
+			t.Fatalf("Todo %q is not a !todo line of its entry", todo.Title)
+		}
+	}
+	if linked != int(float64(opts.Todos)*linkedShare) {
+		t.Errorf("Linked todos = %d", linked)
+	}
+
+	// Old todos are mostly done, recent ones mostly not
+	var oldDone, old, newDone, recent int
+	for _, todo := range journal.Todos {
+		done := todo.Status == "done"
+		if done && (todo.CompletedAt == nil || todo.CompletedAt.Before(todo.CreatedAt)) {
+			t.Fatalf("Bad completion time: %+v", todo)
+		}
+		switch age := opts.End.Sub(todo.CreatedAt); {
+		case age > 120*24*time.Hour:
+			old++
+			if done {
+				oldDone++
+			}
+		case age < 7*24*time.Hour:
+			recent++
+			if done {
+				newDone++
+			}
+		}
+	}
+	if old == 0 || recent == 0 || float64(oldDone)/float64(old) < 0.7 || float64(newDone)/float64(recent) > 0.5 {
+		t.Errorf("Done: %d/%d old, %d/%d recent", oldDone, old, newDone, recent)
+	}
+
+	// Zipf tags: the top tag is far more common than the rarest, and nested tags show up
+	counts := map[string]int{}
+	for _, entry := range journal.Entries {
+		for _, tag := range entry.Tags {
+			counts[tag]++
+		}
+	}
+	if counts["work"] < 5*counts[tagsByRank[len(tagsByRank)-1]] || counts["project/alpha/infra"] == 0 {
+		t.Errorf("Tag counts = %v", counts)
+	}
+}
+
+func TestActivityDays(t *testing.T) {
+	end := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC) // A Monday
+	days := activityDays(rand.New(rand.NewSource(1)), end, 28, 1)
+	if len(days) != 28 || !days[27].date.Equal(end) {
+		t.Fatalf("Days = %+v", days)
+	}
+
+	silent := 0
+	for _, day := range days {
+		weekend := day.date.Weekday() == time.Saturday || day.date.Weekday() == time.Sunday
+		switch {
+		case day.weight == 0:
+			silent++
+		case weekend && day.weight > 0.6, !weekend && day.weight < 1:
+			t.Errorf("%s weighs %v", day.date.Format("Mon 2006-01-02"), day.weight)
+		}
+	}
+	if silent != 7 && silent != 14 {
+		t.Errorf("Vacation days = %d, want one or two weeks", silent)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	home := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", originalHome)
+
+	opts := testOptions()
+	opts.Entries, opts.Todos = 10, 5
+	journal := Generate(opts)
+
+	// Never the real journal
+	if err := Write(filepath.Join(home, ".amos"), journal, opts); !errors.Is(err, ErrRealJournal) {
+		t.Errorf("Writing ~/.amos: err = %v", err)
+	}
+
+	// Never a directory with data it didn't generate
+	existing := filepath.Join(home, "copy")
+	os.MkdirAll(existing, 0700)
+	os.WriteFile(filepath.Join(existing, "entries.json"), []byte("[]"), 0600)
+	if err := Write(existing, journal, opts); err == nil || !strings.Contains(err.Error(), "wasn't generated") {
+		t.Errorf("Writing over real data: err = %v", err)
+	}
+
+	// A new directory, then again over its own output
+	dir := filepath.Join(home, "test", ".amos")
+	for i := 0; i < 2; i++ {
+		if err := Write(dir, journal, opts); err != nil {
+			t.Fatalf("Write() #%d failed: %v", i+1, err)
+		}
+	}
+	for _, name := range []string{"entries.json", "todos.json", markerFile} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Missing %s: %v", name, err)
+		}
+	}
+}
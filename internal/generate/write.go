@@ -0,0 +1,75 @@
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/apodacaa/amos/internal/storage"
+)
+
+// markerFile records the options a directory was generated with; only marked directories are overwritten
+const markerFile = "generator.json"
+
+// ErrRealJournal is returned when asked to write into the journal amos actually uses (~/.amos)
+var ErrRealJournal = errors.New("refusing to write into the real journal")
+
+// Write saves journal as entries.json and todos.json in dir (created if missing), plus generator.json
+// It refuses the real journal, and any directory holding data files it didn't generate itself
+func Write(dir string, journal Journal, opts Options) error {
+	if dir == "" {
+		return errors.New("no output directory")
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	journalDir, err := storage.GetAmosDir()
+	if err != nil {
+		return err
+	}
+	if samePath(abs, journalDir) {
+		return fmt.Errorf("%w (%s)", ErrRealJournal, journalDir)
+	}
+
+	if _, err := os.Stat(filepath.Join(abs, markerFile)); errors.Is(err, os.ErrNotExist) {
+		for _, name := range []string{"entries.json", "todos.json"} {
+			if _, err := os.Stat(filepath.Join(abs, name)); err == nil {
+				return fmt.Errorf("%s already has %s and wasn't generated: pick an empty directory", abs, name)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(abs, 0700); err != nil {
+		return err
+	}
+	if err := writeJSON(filepath.Join(abs, markerFile), opts); err != nil {
+		return err
+	}
+	if err := writeJSON(filepath.Join(abs, "entries.json"), journal.Entries); err != nil {
+		return err
+	}
+	return writeJSON(filepath.Join(abs, "todos.json"), journal.Todos)
+}
+
+// samePath reports whether a and b name the same directory (following symlinks when they exist)
+func samePath(a, b string) bool {
+	if resolved, err := filepath.EvalSymlinks(a); err == nil {
+		a = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(b); err == nil {
+		b = resolved
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
+// writeJSON writes v indented, private to the user like the rest of the data directory
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// DefaultArchiveDoneAfterDays is used when config.json doesn't set archive_done_after_days
+const DefaultArchiveDoneAfterDays = 14
+
+// ArchiveDoneAfterDays returns how many days done todos stay in todos.json (0 = never auto-archive)
+func ArchiveDoneAfterDays(cfg models.Config) int {
+	if cfg.ArchiveDoneAfterDays == nil {
+		return DefaultArchiveDoneAfterDays
+	}
+	if *cfg.ArchiveDoneAfterDays < 0 {
+		return 0
+	}
+	return *cfg.ArchiveDoneAfterDays
+}
+
+// DoneTodosToArchive returns the IDs of done todos finished more than days ago
+// Legacy done todos without CompletedAt use CreatedAt; a restore from the archive restarts the clock
+func DoneTodosToArchive(todos []models.Todo, days int, now time.Time) map[string]bool {
+	ids := make(map[string]bool)
+	if days <= 0 {
+		return ids
+	}
+
+	cutoff := now.AddDate(0, 0, -days)
+	for _, todo := range todos {
+		if todo.Status != "done" {
+			continue
+		}
+		doneAt := todo.CreatedAt
+		if todo.CompletedAt != nil {
+			doneAt = *todo.CompletedAt
+		}
+		if todo.RestoredAt != nil && todo.RestoredAt.After(doneAt) {
+			doneAt = *todo.RestoredAt
+		}
+		if doneAt.Before(cutoff) {
+			ids[todo.ID] = true
+		}
+	}
+	return ids
+}
+
+// SortArchivedTodos returns archived todos most recently archived first (stable, input untouched)
+func SortArchivedTodos(todos []models.Todo) []models.Todo {
+	sorted := append([]models.Todo{}, todos...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].ArchivedAt, sorted[j].ArchivedAt
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.After(*b)
+	})
+	return sorted
+}
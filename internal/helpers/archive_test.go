@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestArchiveDoneAfterDays(t *testing.T) {
+	days := func(n int) *int { return &n }
+
+	tests := []struct {
+		name string
+		cfg  models.Config
+		want int
+	}{
+		{name: "default", cfg: models.Config{}, want: DefaultArchiveDoneAfterDays},
+		{name: "configured", cfg: models.Config{ArchiveDoneAfterDays: days(30)}, want: 30},
+		{name: "disabled", cfg: models.Config{ArchiveDoneAfterDays: days(0)}, want: 0},
+		{name: "negative means disabled", cfg: models.Config{ArchiveDoneAfterDays: days(-1)}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ArchiveDoneAfterDays(tt.cfg); got != tt.want {
+				t.Errorf("ArchiveDoneAfterDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoneTodosToArchive(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) *time.Time {
+		at := now.AddDate(0, 0, -n)
+		return &at
+	}
+
+	todos := []models.Todo{
+		{ID: "old-done", Status: "done", CreatedAt: *daysAgo(40), CompletedAt: daysAgo(20)},
+		{ID: "recent-done", Status: "done", CreatedAt: *daysAgo(40), CompletedAt: daysAgo(3)},
+		{ID: "legacy-done", Status: "done", CreatedAt: *daysAgo(30)},
+		{ID: "old-open", Status: "open", CreatedAt: *daysAgo(90)},
+		{ID: "restored", Status: "done", CreatedAt: *daysAgo(60), CompletedAt: daysAgo(50), RestoredAt: daysAgo(1)},
+	}
+
+	got := DoneTodosToArchive(todos, 14, now)
+	if len(got) != 2 || !got["old-done"] || !got["legacy-done"] {
+		t.Errorf("Expected old-done and legacy-done, got %v", got)
+	}
+
+	if got := DoneTodosToArchive(todos, 0, now); len(got) != 0 {
+		t.Errorf("Expected nothing when disabled, got %v", got)
+	}
+}
+
+func TestSortArchivedTodos(t *testing.T) {
+	at := func(day int) *time.Time {
+		ts := time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)
+		return &ts
+	}
+	todos := []models.Todo{
+		{ID: "1", ArchivedAt: at(1)},
+		{ID: "2"},
+		{ID: "3", ArchivedAt: at(5)},
+		{ID: "4", ArchivedAt: at(1)},
+	}
+
+	sorted := SortArchivedTodos(todos)
+
+	want := []string{"3", "1", "4", "2"}
+	for i, id := range want {
+		if sorted[i].ID != id {
+			t.Fatalf("Position %d = %s, want %s (got %v)", i, sorted[i].ID, id, sorted)
+		}
+	}
+	if todos[0].ID != "1" || todos[1].ID != "2" {
+		t.Error("Expected input order untouched")
+	}
+}
@@ -0,0 +1,194 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// BulkAction is one operation applied to every marked todo
+type BulkAction struct {
+	Kind   string     // "status", "add_tag", "remove_tag", "due", "archive" or "delete"
+	Status string     // Target status (Kind "status")
+	Tag    string     // Bare tag name without @ (Kind "add_tag"/"remove_tag")
+	Due    *time.Time // New due day, nil clears it (Kind "due")
+}
+
+// BulkCommandHint lists the commands accepted by ParseBulkCommand
+const BulkCommandHint = "open | next | done | status <name> | @tag | -@tag | due <day> | due none | archive | delete"
+
+// ParseBulkCommand parses a bulk command typed in the todos list
+// Examples: "done", "status waiting", "@work", "-@work", "due fri", "due none", "archive", "delete"
+func ParseBulkCommand(input string, now time.Time) (BulkAction, error) {
+	fields := strings.Fields(strings.TrimSpace(input))
+	if len(fields) == 0 {
+		return BulkAction{}, fmt.Errorf("empty command")
+	}
+
+	command := strings.ToLower(fields[0])
+	switch {
+	case command == "open" || command == "next" || command == "done":
+		if len(fields) != 1 {
+			return BulkAction{}, fmt.Errorf("%s takes no arguments", command)
+		}
+		return BulkAction{Kind: "status", Status: command}, nil
+
+	case command == "status":
+		if len(fields) != 2 {
+			return BulkAction{}, fmt.Errorf("usage: status <name>")
+		}
+		return BulkAction{Kind: "status", Status: strings.ToLower(fields[1])}, nil
+
+	case strings.HasPrefix(command, "-@") || strings.HasPrefix(command, "@"):
+		if len(fields) != 1 {
+			return BulkAction{}, fmt.Errorf("one tag at a time")
+		}
+		kind := "add_tag"
+		if strings.HasPrefix(command, "-") {
+			kind = "remove_tag"
+		}
+		tag, ok := NormalizeTagName(strings.TrimPrefix(command, "-"))
+		if !ok {
+			return BulkAction{}, fmt.Errorf("invalid tag %q", fields[0])
+		}
+		return BulkAction{Kind: kind, Tag: tag}, nil
+
+	case command == "due":
+		if len(fields) != 2 {
+			return BulkAction{}, fmt.Errorf("usage: due <day> or due none")
+		}
+		if strings.EqualFold(fields[1], "none") {
+			return BulkAction{Kind: "due"}, nil
+		}
+		day, ok := ParseDay(fields[1], now)
+		if !ok {
+			return BulkAction{}, fmt.Errorf("unknown day %q (today, tomorrow, fri, 2006-01-02)", fields[1])
+		}
+		return BulkAction{Kind: "due", Due: &day}, nil
+
+	case command == "archive":
+		if len(fields) != 1 {
+			return BulkAction{}, fmt.Errorf("archive takes no arguments")
+		}
+		return BulkAction{Kind: "archive"}, nil
+
+	case command == "delete":
+		if len(fields) != 1 {
+			return BulkAction{}, fmt.Errorf("delete takes no arguments")
+		}
+		return BulkAction{Kind: "delete"}, nil
+	}
+
+	return BulkAction{}, fmt.Errorf("unknown command %q", fields[0])
+}
+
+// ApplyBulkAction applies action to the todos whose IDs are in ids
+// Returns the updated list and how many todos actually changed
+// "archive" and "delete" are not applied here (storage moves those todos out of todos.json)
+func ApplyBulkAction(todos []models.Todo, ids map[string]bool, action BulkAction, now time.Time) ([]models.Todo, int) {
+	updated := make([]models.Todo, len(todos))
+	changed := 0
+
+	for i, todo := range todos {
+		updated[i] = todo
+		if !ids[todo.ID] {
+			continue
+		}
+
+		next, ok := applyBulkToTodo(todo, action, now)
+		if ok {
+			updated[i] = next
+			changed++
+		}
+	}
+
+	return updated, changed
+}
+
+// applyBulkToTodo applies one action to one todo and reports whether it changed
+func applyBulkToTodo(todo models.Todo, action BulkAction, now time.Time) (models.Todo, bool) {
+	switch action.Kind {
+	case "status":
+		if todo.Status == action.Status {
+			return todo, false
+		}
+		todo.Status = action.Status
+		todo.CompletedAt = nil
+		if todo.Status == "done" {
+			completedAt := now
+			todo.CompletedAt = &completedAt
+		}
+		return todo, true
+
+	case "add_tag":
+		for _, tag := range todo.Tags {
+			if tag == action.Tag {
+				return todo, false
+			}
+		}
+		// Keep the title as the source of tags (re-extraction finds it again)
+		todo.Title = strings.TrimSpace(todo.Title) + " @" + action.Tag
+		todo.Tags = append(append([]string{}, todo.Tags...), action.Tag)
+		return todo, true
+
+	case "remove_tag":
+		tags := make([]string, 0, len(todo.Tags))
+		for _, tag := range todo.Tags {
+			if tag != action.Tag {
+				tags = append(tags, tag)
+			}
+		}
+		words := strings.Fields(todo.Title)
+		kept := make([]string, 0, len(words))
+		for _, word := range words {
+			if !strings.EqualFold(word, "@"+action.Tag) {
+				kept = append(kept, word)
+			}
+		}
+		if len(tags) == len(todo.Tags) && len(kept) == len(words) {
+			return todo, false
+		}
+		todo.Tags = tags
+		todo.Title = strings.Join(kept, " ")
+		return todo, true
+
+	case "due":
+		if (todo.Due == nil && action.Due == nil) || (todo.Due != nil && action.Due != nil && todo.Due.Equal(*action.Due)) {
+			return todo, false
+		}
+		todo.Due = action.Due
+		return todo, true
+	}
+
+	return todo, false
+}
+
+// DescribeBulkResult builds the single summary status shown after a bulk action
+func DescribeBulkResult(action BulkAction, changed, selected int) string {
+	var summary string
+	switch action.Kind {
+	case "status":
+		summary = fmt.Sprintf("%d todos → %s", changed, action.Status)
+	case "add_tag":
+		summary = fmt.Sprintf("tagged %d todos @%s", changed, action.Tag)
+	case "remove_tag":
+		summary = fmt.Sprintf("removed @%s from %d todos", action.Tag, changed)
+	case "due":
+		if action.Due == nil {
+			summary = fmt.Sprintf("cleared due date on %d todos", changed)
+		} else {
+			summary = fmt.Sprintf("%d todos due %s", changed, action.Due.Format("Mon 2006-01-02"))
+		}
+	case "archive":
+		summary = fmt.Sprintf("archived %d todos", changed)
+	case "delete":
+		summary = fmt.Sprintf("moved %d todos to trash", changed)
+	}
+
+	if unchanged := selected - changed; unchanged > 0 {
+		summary += fmt.Sprintf(" (%d unchanged)", unchanged)
+	}
+	return summary
+}
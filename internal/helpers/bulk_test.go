@@ -0,0 +1,112 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestParseBulkCommand(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		input   string
+		want    BulkAction
+		wantDue string
+		wantErr bool
+	}{
+		{input: "done", want: BulkAction{Kind: "status", Status: "done"}},
+		{input: " Next ", want: BulkAction{Kind: "status", Status: "next"}},
+		{input: "status Waiting", want: BulkAction{Kind: "status", Status: "waiting"}},
+		{input: "@Work/API", want: BulkAction{Kind: "add_tag", Tag: "work/api"}},
+		{input: "-@work", want: BulkAction{Kind: "remove_tag", Tag: "work"}},
+		{input: "due fri", want: BulkAction{Kind: "due"}, wantDue: "2026-10-16"},
+		{input: "due none", want: BulkAction{Kind: "due"}},
+		{input: "archive", want: BulkAction{Kind: "archive"}},
+		{input: "delete", want: BulkAction{Kind: "delete"}},
+		{input: "", wantErr: true},
+		{input: "due someday", wantErr: true},
+		{input: "@bad!tag", wantErr: true},
+		{input: "done now", wantErr: true},
+		{input: "explode", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBulkCommand(tt.input, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBulkCommand(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got.Kind != tt.want.Kind || got.Status != tt.want.Status || got.Tag != tt.want.Tag {
+			t.Errorf("ParseBulkCommand(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+		gotDue := ""
+		if got.Due != nil {
+			gotDue = got.Due.Format("2006-01-02")
+		}
+		if gotDue != tt.wantDue {
+			t.Errorf("ParseBulkCommand(%q) due = %q, want %q", tt.input, gotDue, tt.wantDue)
+		}
+	}
+}
+
+func TestApplyBulkAction(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC)
+	todos := []models.Todo{
+		{ID: "1", Title: "Write report @work", Status: "open", Tags: []string{"work"}},
+		{ID: "2", Title: "Call mum", Status: "done", CompletedAt: &now},
+		{ID: "3", Title: "Not selected @work", Status: "open", Tags: []string{"work"}},
+	}
+	ids := map[string]bool{"1": true, "2": true}
+
+	// Status: only todo 1 changes, gets CompletedAt
+	updated, changed := ApplyBulkAction(todos, ids, BulkAction{Kind: "status", Status: "done"}, now)
+	if changed != 1 {
+		t.Errorf("Expected 1 status change, got %d", changed)
+	}
+	if updated[0].Status != "done" || updated[0].CompletedAt == nil {
+		t.Errorf("Expected todo 1 done with CompletedAt, got %+v", updated[0])
+	}
+	if todos[0].Status != "open" {
+		t.Error("ApplyBulkAction must not modify the input slice")
+	}
+
+	// Add tag: appended to title and tags, existing tag is a no-op
+	updated, changed = ApplyBulkAction(todos, ids, BulkAction{Kind: "add_tag", Tag: "work"}, now)
+	if changed != 1 || updated[1].Title != "Call mum @work" || len(updated[1].Tags) != 1 {
+		t.Errorf("Unexpected add_tag result: changed=%d todo=%+v", changed, updated[1])
+	}
+
+	// Remove tag: removed from title and tags, unselected todo untouched
+	updated, changed = ApplyBulkAction(todos, ids, BulkAction{Kind: "remove_tag", Tag: "work"}, now)
+	if changed != 1 || updated[0].Title != "Write report" || len(updated[0].Tags) != 0 {
+		t.Errorf("Unexpected remove_tag result: changed=%d todo=%+v", changed, updated[0])
+	}
+	if len(updated[2].Tags) != 1 {
+		t.Errorf("Unselected todo should keep its tag, got %+v", updated[2])
+	}
+
+	// Due: set on both selected
+	updated, changed = ApplyBulkAction(todos, ids, BulkAction{Kind: "due", Due: &now}, now)
+	if changed != 2 || updated[0].Due == nil || updated[2].Due != nil {
+		t.Errorf("Unexpected due result: changed=%d", changed)
+	}
+}
+
+func TestDescribeBulkResult(t *testing.T) {
+	got := DescribeBulkResult(BulkAction{Kind: "status", Status: "done"}, 3, 5)
+	if got != "3 todos → done (2 unchanged)" {
+		t.Errorf("Unexpected summary %q", got)
+	}
+	if got := DescribeBulkResult(BulkAction{Kind: "archive"}, 4, 4); !strings.HasPrefix(got, "archived 4 todos") || strings.Contains(got, "unchanged") {
+		t.Errorf("Unexpected summary %q", got)
+	}
+	if got := DescribeBulkResult(BulkAction{Kind: "delete"}, 2, 2); got != "moved 2 todos to trash" {
+		t.Errorf("Unexpected summary %q", got)
+	}
+}
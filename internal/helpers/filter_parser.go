@@ -1,23 +1,31 @@
 package helpers
 
 import (
+	"regexp"
 	"strings"
 )
 
+// quotedPattern matches "quoted phrases" (free-text search terms); a missing closing quote runs to the end
+var quotedPattern = regexp.MustCompile(`"([^"]*)"?`)
+
 // FilterResult holds parsed filter components from user input
 type FilterResult struct {
 	Tags     []string
+	People   []string
+	Text     []string // Quoted phrases searched in titles, bodies and notes (lowercase, no quotes)
 	Date     string
 	Errors   []string
 	Warnings []string
 }
 
 // ParseFilterInput parses a unified filter input string
-// Supports mixed input like "@client last 30 days" or "yesterday @work @urgent"
-// Returns FilterResult with parsed tags, date preset, and any errors
+// Supports mixed input like "@client last 30 days" or "yesterday @work +alice \"rollback\""
+// Returns FilterResult with parsed tags, people, date preset, and any errors
 func ParseFilterInput(input string) FilterResult {
 	result := FilterResult{
 		Tags:     []string{},
+		People:   []string{},
+		Text:     []string{},
 		Date:     "",
 		Errors:   []string{},
 		Warnings: []string{},
@@ -27,6 +35,17 @@ func ParseFilterInput(input string) FilterResult {
 		return result
 	}
 
+	// Pass 0: Extract quoted phrases (free text), then parse the rest word by word
+	seenText := make(map[string]bool)
+	for _, match := range quotedPattern.FindAllStringSubmatch(input, -1) {
+		phrase := strings.ToLower(strings.Join(strings.Fields(match[1]), " "))
+		if phrase != "" && !seenText[phrase] {
+			seenText[phrase] = true
+			result.Text = append(result.Text, phrase)
+		}
+	}
+	input = quotedPattern.ReplaceAllString(input, " ")
+
 	input = strings.TrimSpace(input)
 	words := strings.Fields(input)
 
@@ -48,6 +67,19 @@ func ParseFilterInput(input string) FilterResult {
 		result.Tags = append(result.Tags, tag)
 	}
 
+	// Pass 1b: Extract people (words starting with + followed by a name)
+	personMap := make(map[string]bool)
+	for i, word := range words {
+		if len(word) > 1 && strings.HasPrefix(word, "+") {
+			person := strings.ToLower(word)
+			if !personMap[person] {
+				personMap[person] = true
+				result.People = append(result.People, person)
+			}
+			consumedIndices[i] = true
+		}
+	}
+
 	// Pass 2: Extract date phrases
 	// Try to match multi-word date phrases first, then single words
 	dateFound := false
@@ -124,7 +156,7 @@ func ParseFilterInput(input string) FilterResult {
 
 // GetFilterHint returns a usage hint for the filter input
 func GetFilterHint() string {
-	return "e.g. @work yesterday, last 30 days @client"
+	return "e.g. @work yesterday, last 30 days @client +alice \"rollback\""
 }
 
 // GetDateSuggestions returns available date filter options for autocomplete
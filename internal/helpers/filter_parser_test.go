@@ -0,0 +1,80 @@
+package helpers
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParseFilterInput(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantTags   []string
+		wantPeople []string
+		wantText   []string
+		wantDate   string
+		wantErrors int
+	}{
+		{
+			name:       "tags and date",
+			input:      "@client last 30 days",
+			wantTags:   []string{"@client"},
+			wantPeople: []string{},
+			wantDate:   DateFilterLast30Days,
+		},
+		{
+			name:       "people are lowercased and deduplicated",
+			input:      "+Alice +alice @work",
+			wantTags:   []string{"@work"},
+			wantPeople: []string{"+alice"},
+		},
+		{
+			name:       "people with date",
+			input:      "yesterday +bob +carol",
+			wantTags:   []string{},
+			wantPeople: []string{"+bob", "+carol"},
+			wantDate:   DateFilterYesterday,
+		},
+		{
+			name:       "lone plus is unrecognized",
+			input:      "+ @work",
+			wantTags:   []string{"@work"},
+			wantPeople: []string{},
+			wantErrors: 1,
+		},
+		{
+			name:       "quoted phrases are text search",
+			input:      `@work "Kubectl  rollout" "kubectl rollout" "wiki`,
+			wantTags:   []string{"@work"},
+			wantPeople: []string{},
+			wantText:   []string{"kubectl rollout", "wiki"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseFilterInput(tt.input)
+			sort.Strings(got.Tags)
+			if !reflect.DeepEqual(got.Tags, tt.wantTags) {
+				t.Errorf("Tags = %v, want %v", got.Tags, tt.wantTags)
+			}
+			if !reflect.DeepEqual(got.People, tt.wantPeople) {
+				t.Errorf("People = %v, want %v", got.People, tt.wantPeople)
+			}
+			wantText := tt.wantText
+			if wantText == nil {
+				wantText = []string{}
+			}
+			if !reflect.DeepEqual(got.Text, wantText) {
+				t.Errorf("Text = %v, want %v", got.Text, wantText)
+			}
+			if got.Date != tt.wantDate {
+				t.Errorf("Date = %q, want %q", got.Date, tt.wantDate)
+			}
+			if len(got.Errors) != tt.wantErrors {
+				t.Errorf("Errors = %v, want %d errors", got.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// HeatmapWeeks is the number of week columns in the year heatmap
+const HeatmapWeeks = 53
+
+// HeatmapDay is a single cell in the year heatmap
+type HeatmapDay struct {
+	Date   time.Time
+	Count  int  // Entries written on this day
+	Future bool // Day is after the heatmap end date (rendered blank)
+}
+
+// dayKey normalizes a time to a calendar-day key in its own location
+func dayKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// startOfDay returns midnight of t's calendar day
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// CountEntriesByDay counts entries per calendar day (key format: 2006-01-02)
+func CountEntriesByDay(entries []models.Entry) map[string]int {
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		counts[dayKey(entry.Timestamp)]++
+	}
+	return counts
+}
+
+// BuildYearHeatmap lays out the last HeatmapWeeks weeks ending at end as weeks x weekdays
+// Each week column runs Monday (index 0) to Sunday (index 6), matching ISO weeks
+// Returns columns oldest first; days after end are marked Future
+func BuildYearHeatmap(entries []models.Entry, end time.Time) [][7]HeatmapDay {
+	counts := CountEntriesByDay(entries)
+
+	// Monday of the week containing end
+	endDay := startOfDay(end)
+	weekdayIdx := (int(endDay.Weekday()) + 6) % 7 // Monday=0 ... Sunday=6
+	lastMonday := endDay.AddDate(0, 0, -weekdayIdx)
+	firstMonday := lastMonday.AddDate(0, 0, -7*(HeatmapWeeks-1))
+
+	weeks := make([][7]HeatmapDay, HeatmapWeeks)
+	for w := 0; w < HeatmapWeeks; w++ {
+		for d := 0; d < 7; d++ {
+			date := firstMonday.AddDate(0, 0, w*7+d)
+			weeks[w][d] = HeatmapDay{
+				Date:   date,
+				Count:  counts[dayKey(date)],
+				Future: date.After(endDay),
+			}
+		}
+	}
+
+	return weeks
+}
+
+// JournalingStreaks returns the current and longest runs of consecutive days with at least one entry
+// The current streak stays alive through today: if nothing is written yet today, it counts back from yesterday
+func JournalingStreaks(entries []models.Entry, today time.Time) (current, longest int) {
+	if len(entries) == 0 {
+		return 0, 0
+	}
+
+	counts := CountEntriesByDay(entries)
+
+	// Current streak: walk back from today (or yesterday if today is empty)
+	day := startOfDay(today)
+	if counts[dayKey(day)] == 0 {
+		day = day.AddDate(0, 0, -1)
+	}
+	for counts[dayKey(day)] > 0 {
+		current++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	// Longest streak: scan every day from the first entry to today
+	first := startOfDay(entries[0].Timestamp)
+	for _, entry := range entries {
+		if entry.Timestamp.Before(first) {
+			first = startOfDay(entry.Timestamp)
+		}
+	}
+
+	run := 0
+	for d := first; !d.After(startOfDay(today)); d = d.AddDate(0, 0, 1) {
+		if counts[dayKey(d)] > 0 {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+
+	return current, longest
+}
@@ -0,0 +1,133 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestCountEntriesByDay(t *testing.T) {
+	day := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	entries := []models.Entry{
+		{ID: "1", Timestamp: day},
+		{ID: "2", Timestamp: day.Add(10 * time.Hour)},
+		{ID: "3", Timestamp: day.AddDate(0, 0, 1)},
+	}
+
+	counts := CountEntriesByDay(entries)
+
+	if counts["2026-10-14"] != 2 {
+		t.Errorf("Expected 2 entries on 2026-10-14, got %d", counts["2026-10-14"])
+	}
+	if counts["2026-10-15"] != 1 {
+		t.Errorf("Expected 1 entry on 2026-10-15, got %d", counts["2026-10-15"])
+	}
+}
+
+func TestBuildYearHeatmap(t *testing.T) {
+	// Wednesday, ISO week 42
+	end := time.Date(2026, 10, 14, 18, 0, 0, 0, time.UTC)
+	entries := []models.Entry{
+		{ID: "1", Timestamp: end},
+		{ID: "2", Timestamp: end.AddDate(0, 0, -2)}, // Monday
+	}
+
+	weeks := BuildYearHeatmap(entries, end)
+
+	if len(weeks) != HeatmapWeeks {
+		t.Fatalf("Expected %d weeks, got %d", HeatmapWeeks, len(weeks))
+	}
+
+	last := weeks[len(weeks)-1]
+
+	// Columns start on Monday
+	if last[0].Date.Weekday() != time.Monday {
+		t.Errorf("Expected week to start on Monday, got %v", last[0].Date.Weekday())
+	}
+	if last[0].Count != 1 {
+		t.Errorf("Expected 1 entry on Monday, got %d", last[0].Count)
+	}
+	if last[2].Count != 1 || last[2].Future {
+		t.Errorf("Expected Wednesday to have 1 entry and not be future, got %+v", last[2])
+	}
+	// Thursday onwards is in the future
+	for d := 3; d < 7; d++ {
+		if !last[d].Future {
+			t.Errorf("Expected weekday %d to be future", d)
+		}
+	}
+
+	// First column is 52 weeks before the last
+	if got := last[0].Date.Sub(weeks[0][0].Date); got != 52*7*24*time.Hour {
+		t.Errorf("Expected 52 weeks between first and last column, got %v", got)
+	}
+}
+
+func TestJournalingStreaks(t *testing.T) {
+	today := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return today.AddDate(0, 0, offset) }
+
+	tests := []struct {
+		name        string
+		entries     []models.Entry
+		wantCurrent int
+		wantLongest int
+	}{
+		{
+			name:        "no entries",
+			entries:     []models.Entry{},
+			wantCurrent: 0,
+			wantLongest: 0,
+		},
+		{
+			name: "streak including today",
+			entries: []models.Entry{
+				{ID: "1", Timestamp: day(0)},
+				{ID: "2", Timestamp: day(-1)},
+				{ID: "3", Timestamp: day(-2)},
+			},
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name: "nothing yet today keeps yesterday's streak alive",
+			entries: []models.Entry{
+				{ID: "1", Timestamp: day(-1)},
+				{ID: "2", Timestamp: day(-2)},
+			},
+			wantCurrent: 2,
+			wantLongest: 2,
+		},
+		{
+			name: "broken streak with longer past run",
+			entries: []models.Entry{
+				{ID: "1", Timestamp: day(0)},
+				{ID: "2", Timestamp: day(-10)},
+				{ID: "3", Timestamp: day(-11)},
+				{ID: "4", Timestamp: day(-11)}, // Two entries same day count once
+				{ID: "5", Timestamp: day(-12)},
+				{ID: "6", Timestamp: day(-13)},
+			},
+			wantCurrent: 1,
+			wantLongest: 4,
+		},
+		{
+			name: "gap of two days ends current streak",
+			entries: []models.Entry{
+				{ID: "1", Timestamp: day(-2)},
+			},
+			wantCurrent: 0,
+			wantLongest: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := JournalingStreaks(tt.entries, today)
+			if current != tt.wantCurrent || longest != tt.wantLongest {
+				t.Errorf("JournalingStreaks() = %d, %d; want %d, %d", current, longest, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package helpers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// Integrity problem kinds, in report order
+const (
+	ProblemMissingTodo   = "missing todo"   // Entry.TodoIDs names a todo in no file
+	ProblemMissingEntry  = "missing entry"  // Todo.EntryID names an entry that doesn't exist
+	ProblemDuplicateID   = "duplicate id"   // Two entries (or two todos, across all todo files) share an ID
+	ProblemUnknownStatus = "unknown status" // Empty, not lowercase, or with spaces (custom statuses like "waiting" are fine)
+	ProblemTagCase       = "tag case"       // A tag that isn't a lowercase bare name (e.g. "Work", "@dev")
+)
+
+// ProblemKinds lists the integrity problem kinds in report order
+var ProblemKinds = []string{ProblemMissingTodo, ProblemMissingEntry, ProblemDuplicateID, ProblemUnknownStatus, ProblemTagCase}
+
+// IntegrityProblem is one inconsistency found in the data files
+type IntegrityProblem struct {
+	Kind   string // One of ProblemKinds
+	File   string // Data file it is in, e.g. "entries.json"
+	ID     string // Entry or todo ID
+	Detail string // What is wrong, e.g. `links todo "abc", which doesn't exist`
+}
+
+// IntegrityReport is the result of CheckIntegrity
+type IntegrityReport struct {
+	Problems []IntegrityProblem
+}
+
+// Count returns how many problems of a kind were found
+func (r IntegrityReport) Count(kind string) int {
+	count := 0
+	for _, problem := range r.Problems {
+		if problem.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// FormatIntegrityReport renders a report as text: one line per problem, then counts per kind
+func FormatIntegrityReport(report IntegrityReport) string {
+	if len(report.Problems) == 0 {
+		return "No problems found\n"
+	}
+
+	var b strings.Builder
+	for _, problem := range report.Problems {
+		fmt.Fprintf(&b, "%s: %s %s: %s\n", problem.Kind, problem.File, problem.ID, problem.Detail)
+	}
+	b.WriteString("\n")
+	for _, kind := range ProblemKinds {
+		if count := report.Count(kind); count > 0 {
+			fmt.Fprintf(&b, "%5d %s\n", count, kind)
+		}
+	}
+	fmt.Fprintf(&b, "%5d problems\n", len(report.Problems))
+	return b.String()
+}
+
+// CheckIntegrity finds inconsistencies across entries and the three todo files
+// Entries keep links to archived and deleted todos, so those count as existing
+func CheckIntegrity(entries []models.Entry, todos, archived, trashed []models.Todo) IntegrityReport {
+	var problems []IntegrityProblem
+	todoFiles := []struct {
+		name  string
+		todos []models.Todo
+	}{{"todos.json", todos}, {"archive.json", archived}, {"trash.json", trashed}}
+
+	// Duplicates first: the link checks below assume IDs resolve
+	entryIDs := map[string]bool{}
+	for _, entry := range entries {
+		if entryIDs[entry.ID] {
+			problems = append(problems, IntegrityProblem{ProblemDuplicateID, "entries.json", entry.ID, fmt.Sprintf("entry %q shares its ID with an earlier entry", entry.Title)})
+		}
+		entryIDs[entry.ID] = true
+	}
+	todoIDs := map[string]string{} // ID -> file it was first seen in
+	for _, file := range todoFiles {
+		for _, todo := range file.todos {
+			if first, seen := todoIDs[todo.ID]; seen {
+				problems = append(problems, IntegrityProblem{ProblemDuplicateID, file.name, todo.ID, fmt.Sprintf("todo %q shares its ID with a todo in %s", todo.Title, first)})
+				continue
+			}
+			todoIDs[todo.ID] = file.name
+		}
+	}
+
+	for _, entry := range entries {
+		for _, id := range entry.TodoIDs {
+			if _, ok := todoIDs[id]; !ok {
+				problems = append(problems, IntegrityProblem{ProblemMissingTodo, "entries.json", entry.ID, fmt.Sprintf("entry %q links todo %q, which doesn't exist", entry.Title, id)})
+			}
+		}
+	}
+	for _, file := range todoFiles {
+		for _, todo := range file.todos {
+			if todo.EntryID != nil && !entryIDs[*todo.EntryID] {
+				problems = append(problems, IntegrityProblem{ProblemMissingEntry, file.name, todo.ID, fmt.Sprintf("todo %q belongs to entry %q, which doesn't exist", todo.Title, *todo.EntryID)})
+			}
+		}
+	}
+
+	for _, file := range todoFiles {
+		for _, todo := range file.todos {
+			if normalizeStatus(todo.Status) != todo.Status {
+				problems = append(problems, IntegrityProblem{ProblemUnknownStatus, file.name, todo.ID, fmt.Sprintf("todo %q has status %q", todo.Title, todo.Status)})
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		if bad := badTags(entry.Tags); len(bad) > 0 {
+			problems = append(problems, IntegrityProblem{ProblemTagCase, "entries.json", entry.ID, fmt.Sprintf("entry %q has tags %s", entry.Title, strings.Join(bad, ", "))})
+		}
+	}
+	for _, file := range todoFiles {
+		for _, todo := range file.todos {
+			if bad := badTags(todo.Tags); len(bad) > 0 {
+				problems = append(problems, IntegrityProblem{ProblemTagCase, file.name, todo.ID, fmt.Sprintf("todo %q has tags %s", todo.Title, strings.Join(bad, ", "))})
+			}
+		}
+	}
+
+	return IntegrityReport{Problems: problems}
+}
+
+// RepairIntegrity fixes what CheckIntegrity finds, without losing data:
+//   - an exact copy of an earlier entry or todo (same ID, same content) is dropped;
+//     a different item sharing an ID gets a new one from newID
+//   - links to missing todos are removed from entries; todos of missing entries become standalone
+//   - an empty status becomes "open", others are lowercased with spaces turned into "-"
+//   - tags are lowercased (without "@"), keeping one of each
+func RepairIntegrity(entries []models.Entry, todos, archived, trashed []models.Todo, newID func() string) ([]models.Entry, []models.Todo, []models.Todo, []models.Todo) {
+	// Duplicate entries
+	seenEntries := map[string]models.Entry{}
+	fixedEntries := make([]models.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if first, seen := seenEntries[entry.ID]; seen {
+			if reflect.DeepEqual(first, entry) {
+				continue
+			}
+			entry.ID = newID()
+		}
+		seenEntries[entry.ID] = entry
+		fixedEntries = append(fixedEntries, entry)
+	}
+
+	// Duplicate todos, across files (the first one keeps the ID, so entry links keep pointing at it)
+	seenTodos := map[string]models.Todo{}
+	dedupe := func(list []models.Todo) []models.Todo {
+		fixed := make([]models.Todo, 0, len(list))
+		for _, todo := range list {
+			if first, seen := seenTodos[todo.ID]; seen {
+				if reflect.DeepEqual(first, todo) {
+					continue
+				}
+				todo.ID = newID()
+			}
+			seenTodos[todo.ID] = todo
+			fixed = append(fixed, todo)
+		}
+		return fixed
+	}
+	todos, archived, trashed = dedupe(todos), dedupe(archived), dedupe(trashed)
+
+	// Links, statuses and tags
+	for i := range fixedEntries {
+		entry := &fixedEntries[i]
+		if entry.TodoIDs != nil {
+			links := []string{}
+			for _, id := range entry.TodoIDs {
+				if _, ok := seenTodos[id]; ok {
+					links = append(links, id)
+				}
+			}
+			entry.TodoIDs = links
+		}
+		entry.Tags = normalizeTags(entry.Tags)
+	}
+	fix := func(list []models.Todo) {
+		for i := range list {
+			todo := &list[i]
+			if todo.EntryID != nil {
+				if _, ok := seenEntries[*todo.EntryID]; !ok {
+					todo.EntryID = nil
+				}
+			}
+			todo.Status = normalizeStatus(todo.Status)
+			todo.Tags = normalizeTags(todo.Tags)
+		}
+	}
+	fix(todos)
+	fix(archived)
+	fix(trashed)
+
+	return fixedEntries, todos, archived, trashed
+}
+
+// normalizeStatus is the form amos writes statuses in (empty becomes "open")
+func normalizeStatus(status string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(status), "-"))
+	if normalized == "" {
+		return "open"
+	}
+	return normalized
+}
+
+// badTags returns the tags that aren't normalized tag names
+func badTags(tags []string) []string {
+	var bad []string
+	for _, tag := range tags {
+		if name, _ := NormalizeTagName(tag); name != tag || name == "" {
+			bad = append(bad, fmt.Sprintf("%q", tag))
+		}
+	}
+	return bad
+}
+
+// normalizeTags lowercases tags (dropping "@" and empty ones), keeping the first of each
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, tag := range tags {
+		name, _ := NormalizeTagName(tag)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// brokenJournal has one problem of each kind (plus an exact duplicate todo)
+func brokenJournal() ([]models.Entry, []models.Todo, []models.Todo, []models.Todo) {
+	standup, gone := "e1", "gone"
+	entries := []models.Entry{
+		{ID: "e1", Title: "Standup", Tags: []string{"work"}, TodoIDs: []string{"t1", "t-archived", "t-missing"}},
+		{ID: "e1", Title: "Retro", Tags: []string{"Work", "work", "@Team"}},
+	}
+	todos := []models.Todo{
+		{ID: "t1", Title: "Deploy", Status: "open", EntryID: &standup},
+		{ID: "t2", Title: "Orphan", Status: "Next", EntryID: &gone},
+		{ID: "t3", Title: "Waiting", Status: "waiting"},
+		{ID: "t3", Title: "Waiting", Status: "waiting"},
+	}
+	archived := []models.Todo{{ID: "t-archived", Title: "Old", Status: "done", EntryID: &standup}}
+	trashed := []models.Todo{{ID: "t1", Title: "Other deploy", Status: ""}}
+	return entries, todos, archived, trashed
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	report := CheckIntegrity(brokenJournal())
+
+	want := map[string]int{
+		ProblemDuplicateID:   3, // Entry e1, todo t3 (copy), todo t1 (in trash)
+		ProblemMissingTodo:   1, // t-missing (t-archived exists in the archive)
+		ProblemMissingEntry:  1,
+		ProblemUnknownStatus: 2, // "Next" and "" ("waiting" is a custom status)
+		ProblemTagCase:       1, // One entry, however many bad tags
+	}
+	for _, kind := range ProblemKinds {
+		if got := report.Count(kind); got != want[kind] {
+			t.Errorf("Count(%q) = %d, want %d", kind, got, want[kind])
+		}
+	}
+
+	text := FormatIntegrityReport(report)
+	if !strings.Contains(text, `links todo "t-missing"`) || !strings.Contains(text, "    8 problems") {
+		t.Errorf("Unexpected report:\n%s", text)
+	}
+	if got := FormatIntegrityReport(CheckIntegrity(nil, nil, nil, nil)); got != "No problems found\n" {
+		t.Errorf("Empty report = %q", got)
+	}
+}
+
+func TestRepairIntegrity(t *testing.T) {
+	n := 0
+	newID := func() string { n++; return fmt.Sprintf("new%d", n) }
+	entries, todos, archived, trashed := brokenJournal()
+	entries, todos, archived, trashed = RepairIntegrity(entries, todos, archived, trashed, newID)
+
+	if report := CheckIntegrity(entries, todos, archived, trashed); len(report.Problems) != 0 {
+		t.Fatalf("Problems left after repair:\n%s", FormatIntegrityReport(report))
+	}
+
+	// The second entry keeps its content under a new ID
+	if len(entries) != 2 || entries[1].ID != "new1" || entries[1].Title != "Retro" {
+		t.Errorf("Entries = %+v", entries)
+	}
+	if !reflect.DeepEqual(entries[1].Tags, []string{"work", "team"}) {
+		t.Errorf("Tags = %v, want [work team]", entries[1].Tags)
+	}
+	if !reflect.DeepEqual(entries[0].TodoIDs, []string{"t1", "t-archived"}) {
+		t.Errorf("TodoIDs = %v", entries[0].TodoIDs)
+	}
+
+	// The exact copy is dropped; the orphan becomes standalone
+	if len(todos) != 3 {
+		t.Fatalf("Expected 3 todos, got %+v", todos)
+	}
+	if todos[1].EntryID != nil || todos[1].Status != "next" {
+		t.Errorf("Orphan = %+v", todos[1])
+	}
+	if todos[2].Status != "waiting" {
+		t.Errorf("Custom status changed to %q", todos[2].Status)
+	}
+
+	// A different todo sharing an ID in another file is renamed, not lost
+	if len(trashed) != 1 || trashed[0].ID != "new2" || trashed[0].Status != "open" {
+		t.Errorf("Trashed = %+v", trashed)
+	}
+}
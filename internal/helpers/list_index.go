@@ -0,0 +1,224 @@
+package helpers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// ListFilter is the unified filter (/) applied to the entry and todo lists
+type ListFilter struct {
+	Tags   []string // AND, e.g. "work" (children match)
+	People []string // AND, e.g. "+alice"
+	Text   []string // AND, free-text phrases
+	Date   string   // Date preset ("" = no filter)
+}
+
+// key identifies the filter; relative date presets ("today", "last 7 days") also depend on the day
+func (f ListFilter) key(now time.Time) string {
+	parts := []string{strings.Join(f.Tags, "\x1f"), strings.Join(f.People, "\x1f"), strings.Join(f.Text, "\x1f"), f.Date}
+	if f.Date != "" {
+		parts = append(parts, now.Format("2006-01-02"))
+	}
+	return strings.Join(parts, "\x1e")
+}
+
+// EntryList caches entries filtered and sorted for display (newest first)
+// It is rebuilt only when the entries slice or the filter changes, so moving the selection is O(1)
+// Entries must be replaced, not edited in place (a load assigns a new slice); call Invalidate otherwise
+// Hold it by pointer: the Model is copied on every update and the cache has to survive that
+type EntryList struct {
+	source     []models.Entry // Slice the cache was built from (compared by identity)
+	key        string
+	items      []models.Entry
+	byID       map[string]int
+	hasPrivate bool
+}
+
+// Items returns entries with filter applied, newest first (cached until entries or filter change)
+// A nil EntryList filters and sorts on every call
+func (l *EntryList) Items(entries []models.Entry, filter ListFilter, now time.Time) []models.Entry {
+	if l == nil {
+		return filterAndSortEntries(entries, filter)
+	}
+	key := filter.key(now)
+	if l.byID != nil && key == l.key && sameEntrySlice(entries, l.source) {
+		return l.items
+	}
+
+	l.source, l.key = entries, key
+	l.items = filterAndSortEntries(entries, filter)
+	l.byID = make(map[string]int, len(l.items))
+	l.hasPrivate = false
+	for i, entry := range l.items {
+		if _, seen := l.byID[entry.ID]; !seen {
+			l.byID[entry.ID] = i
+		}
+		if IsPrivateEntry(entry) {
+			l.hasPrivate = true
+		}
+	}
+	return l.items
+}
+
+// IndexOf returns the position of an entry in the last Items result (-1 if not listed)
+func (l *EntryList) IndexOf(id string) int {
+	if l == nil {
+		return -1
+	}
+	if i, ok := l.byID[id]; ok {
+		return i
+	}
+	return -1
+}
+
+// HasPrivate reports whether the last Items result lists a private entry
+func (l *EntryList) HasPrivate() bool {
+	return l != nil && l.hasPrivate
+}
+
+// Invalidate forces the next Items call to rebuild
+func (l *EntryList) Invalidate() {
+	if l != nil {
+		l.byID = nil
+	}
+}
+
+// TodoList caches todos filtered for display (in the order given: the model keeps them sorted)
+// Same rules as EntryList; call Replace after editing one todo in place, Invalidate after anything bigger
+type TodoList struct {
+	source []models.Todo
+	key    string
+	filter ListFilter
+	items  []models.Todo
+	byID   map[string]int
+}
+
+// Items returns todos with filter applied, keeping their order (cached until todos or filter change)
+// A nil TodoList filters on every call
+func (l *TodoList) Items(todos []models.Todo, filter ListFilter, now time.Time) []models.Todo {
+	if l == nil {
+		return filterTodos(todos, filter)
+	}
+	key := filter.key(now)
+	if l.byID != nil && key == l.key && sameTodoSlice(todos, l.source) {
+		return l.items
+	}
+
+	l.source, l.key, l.filter = todos, key, filter
+	l.items = filterTodos(todos, filter)
+	l.byID = make(map[string]int, len(l.items))
+	for i, todo := range l.items {
+		if _, seen := l.byID[todo.ID]; !seen {
+			l.byID[todo.ID] = i
+		}
+	}
+	return l.items
+}
+
+// IndexOf returns the position of a todo in the last Items result (-1 if not listed)
+func (l *TodoList) IndexOf(id string) int {
+	if l == nil {
+		return -1
+	}
+	if i, ok := l.byID[id]; ok {
+		return i
+	}
+	return -1
+}
+
+// Replace updates the cached list after todo was edited in place in the source slice (a toggle, an edit)
+// The cached copy is overwritten while the todo still matches the filter; otherwise only its index
+// is dropped or inserted, so the list is never refiltered
+func (l *TodoList) Replace(todo models.Todo) {
+	if l == nil || l.byID == nil {
+		return // Not built yet: the next Items call builds it
+	}
+	matches := len(filterTodos([]models.Todo{todo}, l.filter)) == 1
+	i, listed := l.byID[todo.ID]
+
+	switch {
+	case listed && matches:
+		l.items[i] = todo
+		return
+	case !listed && !matches:
+		return
+	case len(l.items) > 0 && len(l.source) > 0 && &l.items[0] == &l.source[0]:
+		// An empty filter lists the source slice itself: never shift it
+		l.Invalidate()
+		return
+	}
+
+	if listed {
+		l.items = append(l.items[:i], l.items[i+1:]...)
+		delete(l.byID, todo.ID)
+	} else {
+		var found bool
+		if i, found = l.insertionIndex(todo.ID); !found {
+			l.Invalidate() // Not in the source: nothing to place it after
+			return
+		}
+		l.items = append(l.items, models.Todo{})
+		copy(l.items[i+1:], l.items[i:])
+		l.items[i] = todo
+	}
+	for j := i; j < len(l.items); j++ {
+		l.byID[l.items[j].ID] = j
+	}
+}
+
+// insertionIndex returns where a todo now matching the filter goes: after the nearest listed todo
+// before it in the source (the list keeps source order); false if the source doesn't hold it
+func (l *TodoList) insertionIndex(id string) (int, bool) {
+	at := -1
+	for i := range l.source {
+		if l.source[i].ID == id {
+			at = i
+			break
+		}
+	}
+	if at < 0 {
+		return 0, false
+	}
+	for i := at - 1; i >= 0; i-- {
+		if j, ok := l.byID[l.source[i].ID]; ok {
+			return j + 1, true
+		}
+	}
+	return 0, true
+}
+
+// Invalidate forces the next Items call to rebuild
+func (l *TodoList) Invalidate() {
+	if l != nil {
+		l.byID = nil
+	}
+}
+
+// filterAndSortEntries applies the list filter (date, tags, people, text) and sorts newest first
+func filterAndSortEntries(entries []models.Entry, filter ListFilter) []models.Entry {
+	filtered := FilterEntriesByDateRange(entries, filter.Date)
+	filtered = FilterEntriesByTags(filtered, filter.Tags)
+	filtered = FilterEntriesByPeople(filtered, filter.People)
+	filtered = FilterEntriesByText(filtered, filter.Text)
+	return SortEntriesForDisplay(filtered)
+}
+
+// filterTodos applies the list filter (date, tags, people, text)
+func filterTodos(todos []models.Todo, filter ListFilter) []models.Todo {
+	filtered := FilterTodosByDateRange(todos, filter.Date)
+	filtered = FilterTodosByTags(filtered, filter.Tags)
+	filtered = FilterTodosByPeople(filtered, filter.People)
+	return FilterTodosByText(filtered, filter.Text)
+}
+
+// sameEntrySlice reports whether a and b are the same slice (same backing array and length)
+func sameEntrySlice(a, b []models.Entry) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+// sameTodoSlice reports whether a and b are the same slice (same backing array and length)
+func sameTodoSlice(a, b []models.Todo) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
@@ -0,0 +1,300 @@
+package helpers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// benchSize matches a large journal from scripts/generate_test_data.go
+const benchSize = 100000
+
+// largeJournal builds n entries and n todos spread over the last year, in file order (oldest first)
+func largeJournal(n int) ([]models.Entry, []models.Todo) {
+	start := time.Now().AddDate(-1, 0, 0)
+	tags := []string{"work", "work/meetings", "personal", "health", "ideas"}
+	statuses := []string{"open", "next", "done", "done"}
+	step := 365 * 24 * time.Hour / time.Duration(n)
+
+	entries := make([]models.Entry, n)
+	todos := make([]models.Todo, n)
+	for i := 0; i < n; i++ {
+		at := start.Add(time.Duration(i) * step)
+		entries[i] = models.Entry{
+			ID:        fmt.Sprintf("e%d", i),
+			Title:     fmt.Sprintf("Entry %d", i),
+			Body:      "Notes from the day",
+			Tags:      []string{tags[i%len(tags)]},
+			Timestamp: at,
+		}
+		todos[i] = models.Todo{
+			ID:        fmt.Sprintf("t%d", i),
+			Title:     fmt.Sprintf("Todo %d", i),
+			Status:    statuses[i%len(statuses)],
+			Tags:      []string{tags[i%len(tags)]},
+			CreatedAt: at,
+		}
+	}
+	return entries, todos
+}
+
+func TestEntryListCache(t *testing.T) {
+	now := time.Now()
+	entries := []models.Entry{
+		{ID: "old", Title: "Old", Tags: []string{"work"}, Timestamp: now.Add(-2 * time.Hour)},
+		{ID: "new", Title: "New", Tags: []string{"home"}, Timestamp: now},
+		{ID: "secret", Title: "Secret", Tags: []string{"work", "private"}, Timestamp: now.Add(-time.Hour)},
+	}
+	list := &EntryList{}
+
+	items := list.Items(entries, ListFilter{}, now)
+	if len(items) != 3 || items[0].ID != "new" || list.IndexOf("old") != 2 || !list.HasPrivate() {
+		t.Fatalf("Items = %+v", items)
+	}
+	if again := list.Items(entries, ListFilter{}, now); &again[0] != &items[0] {
+		t.Error("Expected a cache hit to return the same slice")
+	}
+
+	// A filter change rebuilds
+	work := list.Items(entries, ListFilter{Tags: []string{"work"}}, now)
+	if len(work) != 2 || work[0].ID != "secret" || list.IndexOf("new") != -1 {
+		t.Errorf("Filtered = %+v", work)
+	}
+
+	// A new slice rebuilds, even with the same length
+	reloaded := append([]models.Entry(nil), entries...)
+	reloaded[1].Tags = []string{"work"}
+	if got := list.Items(reloaded, ListFilter{Tags: []string{"work"}}, now); len(got) != 3 {
+		t.Errorf("After reload = %+v", got)
+	}
+
+	// Invalidate rebuilds after an in-place edit
+	reloaded[0].Tags = nil
+	list.Invalidate()
+	if got := list.Items(reloaded, ListFilter{Tags: []string{"work"}}, now); len(got) != 2 {
+		t.Errorf("After Invalidate = %+v", got)
+	}
+
+	// A nil list still works (no caching)
+	var none *EntryList
+	if got := none.Items(entries, ListFilter{}, now); len(got) != 3 || none.IndexOf("new") != -1 || none.HasPrivate() {
+		t.Errorf("nil list = %+v", got)
+	}
+}
+
+func TestTodoListCache(t *testing.T) {
+	now := time.Now()
+	todos := SortTodosForDisplay([]models.Todo{
+		{ID: "a", Title: "Deploy", Status: "open", Tags: []string{"work"}, CreatedAt: now},
+		{ID: "b", Title: "Groceries", Status: "next", Tags: []string{"home"}, CreatedAt: now},
+	})
+	list := &TodoList{}
+
+	items := list.Items(todos, ListFilter{Text: []string{"deploy"}}, now)
+	if len(items) != 1 || list.IndexOf("a") != 0 || list.IndexOf("b") != -1 {
+		t.Fatalf("Items = %+v", items)
+	}
+
+	// Order is kept (the caller sorts)
+	if all := list.Items(todos, ListFilter{}, now); all[0].ID != "b" || list.IndexOf("a") != 1 {
+		t.Errorf("All = %+v", all)
+	}
+
+	// Date presets depend on the day, so the next day rebuilds
+	if got := list.Items(todos, ListFilter{Date: "today"}, now.AddDate(0, 0, 1)); got == nil {
+		t.Error("Expected a result for the next day")
+	}
+}
+
+func TestTodoListReplace(t *testing.T) {
+	now := time.Now()
+	todos := []models.Todo{
+		{ID: "a", Title: "Deploy api", Status: "open", CreatedAt: now},
+		{ID: "b", Title: "Groceries", Status: "open", CreatedAt: now},
+		{ID: "c", Title: "Deploy web", Status: "open", CreatedAt: now},
+		{ID: "d", Title: "Deploy docs", Status: "open", CreatedAt: now},
+	}
+	filter := ListFilter{Text: []string{"deploy"}}
+	list := &TodoList{}
+	list.Items(todos, filter, now)
+
+	check := func(step string) {
+		t.Helper()
+		got := list.Items(todos, filter, now)
+		want := filterTodos(todos, filter)
+		if len(got) != len(want) {
+			t.Fatalf("%s: Items = %+v, want %+v", step, got, want)
+		}
+		for i := range want {
+			if got[i].ID != want[i].ID || got[i].Status != want[i].Status || list.IndexOf(want[i].ID) != i {
+				t.Fatalf("%s: Items = %+v, want %+v", step, got, want)
+			}
+		}
+	}
+
+	// Still matching: updated in place
+	todos[2].Status = "done"
+	list.Replace(todos[2])
+	check("toggle")
+
+	// No longer matching: dropped, later ones move up
+	todos[0].Title = "Ship api"
+	list.Replace(todos[0])
+	check("drop")
+
+	// Matching again: back in source order
+	todos[0].Title = "Deploy api again"
+	list.Replace(todos[0])
+	check("insert first")
+	todos[1].Title = "Deploy groceries"
+	list.Replace(todos[1])
+	check("insert middle")
+
+	// An unfiltered list is the source itself and stays correct
+	all := &TodoList{}
+	all.Items(todos, ListFilter{}, now)
+	todos[3].Status = "next"
+	all.Replace(todos[3])
+	if got := all.Items(todos, ListFilter{}, now); got[3].Status != "next" || all.IndexOf("d") != 3 {
+		t.Errorf("Unfiltered = %+v", got)
+	}
+}
+
+func BenchmarkSortEntriesForDisplay(b *testing.B) {
+	entries, _ := largeJournal(benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SortEntriesForDisplay(entries)
+	}
+}
+
+func BenchmarkSortTodosForDisplay(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SortTodosForDisplay(todos)
+	}
+}
+
+// BenchmarkEntryListKeypress is what j/k costs in the entry list and entry view once the list is built
+func BenchmarkEntryListKeypress(b *testing.B) {
+	entries, _ := largeJournal(benchSize)
+	filter := ListFilter{Tags: []string{"work"}}
+	now := time.Now()
+	list := &EntryList{}
+	list.Items(entries, filter, now)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items := list.Items(entries, filter, now)
+		_ = list.IndexOf(items[i%len(items)].ID)
+	}
+}
+
+// BenchmarkEntryListRebuild is the cost of a filter or data change (once, not per keypress)
+func BenchmarkEntryListRebuild(b *testing.B) {
+	entries, _ := largeJournal(benchSize)
+	filter := ListFilter{Tags: []string{"work"}}
+	now := time.Now()
+	list := &EntryList{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Invalidate()
+		list.Items(entries, filter, now)
+	}
+}
+
+func BenchmarkTodoListKeypress(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	todos = SortTodosForDisplay(todos)
+	filter := ListFilter{Text: []string{"todo 1"}}
+	now := time.Now()
+	list := &TodoList{}
+	list.Items(todos, filter, now)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items := list.Items(todos, filter, now)
+		_ = list.IndexOf(items[i%len(items)].ID)
+	}
+}
+
+// BenchmarkEntryListFilterChange is a keypress that changes the filter (typing in the filter bar, a tag jump):
+// every call misses the cache, so it pays the full rebuild plus the lookup
+func BenchmarkEntryListFilterChange(b *testing.B) {
+	entries, _ := largeJournal(benchSize)
+	filters := []ListFilter{{Tags: []string{"work"}}, {Tags: []string{"work"}, Text: []string{"entry 1"}}}
+	now := time.Now()
+	list := &EntryList{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items := list.Items(entries, filters[i%len(filters)], now)
+		_ = list.IndexOf(items[i%len(items)].ID)
+	}
+}
+
+// BenchmarkTodoListRebuild is the cost of a data change in the todos list (once, not per keypress)
+func BenchmarkTodoListRebuild(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	todos = SortTodosForDisplay(todos)
+	filter := ListFilter{Text: []string{"todo 1"}}
+	now := time.Now()
+	list := &TodoList{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Invalidate()
+		list.Items(todos, filter, now)
+	}
+}
+
+// BenchmarkTodoListFilterChange is typing in the todos filter bar: each keystroke is a new filter
+func BenchmarkTodoListFilterChange(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	todos = SortTodosForDisplay(todos)
+	filters := []ListFilter{{Text: []string{"todo 1"}}, {Text: []string{"todo 12"}}}
+	now := time.Now()
+	list := &TodoList{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items := list.Items(todos, filters[i%len(filters)], now)
+		_ = list.IndexOf(items[i%len(items)].ID)
+	}
+}
+
+// BenchmarkTodoListToggle is space on a todo: the status changes in place and the cached list follows
+func BenchmarkTodoListToggle(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	todos = SortTodosForDisplay(todos)
+	filter := ListFilter{Text: []string{"todo 1"}}
+	now := time.Now()
+	list := &TodoList{}
+	items := list.Items(todos, filter, now)
+	target := TodoIndexByID(todos, items[len(items)/2].ID)
+	statuses := []string{"open", "next", "done"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		todos[target].Status = statuses[i%len(statuses)]
+		list.Replace(todos[target])
+		items := list.Items(todos, filter, now)
+		_ = list.IndexOf(items[0].ID)
+	}
+}
+
+// BenchmarkTodoListEditOutOfFilter is an edit that takes a todo out of the filter and back (one index moves)
+func BenchmarkTodoListEditOutOfFilter(b *testing.B) {
+	_, todos := largeJournal(benchSize)
+	todos = SortTodosForDisplay(todos)
+	filter := ListFilter{Text: []string{"todo 1"}}
+	now := time.Now()
+	list := &TodoList{}
+	items := list.Items(todos, filter, now)
+	target := TodoIndexByID(todos, items[len(items)/2].ID)
+	titles := []string{"Renamed", todos[target].Title}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		todos[target].Title = titles[i%len(titles)]
+		list.Replace(todos[target])
+		items := list.Items(todos, filter, now)
+		_ = list.IndexOf(items[0].ID)
+	}
+}
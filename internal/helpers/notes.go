@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// ChecklistProgress counts Markdown checklist items ("- [ ] x", "- [x] x", "* [ ] x") in notes
+func ChecklistProgress(notes string) (done, total int) {
+	for _, line := range strings.Split(notes, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "- [") && !strings.HasPrefix(line, "* [") {
+			continue
+		}
+		if len(line) < 5 || line[4] != ']' {
+			continue
+		}
+		switch line[3] {
+		case ' ':
+			total++
+		case 'x', 'X':
+			done++
+			total++
+		}
+	}
+	return done, total
+}
+
+// FilterTodosByText filters todos to those containing ALL terms in their title or notes
+// Matching is a case-insensitive substring search; returns the original list if terms is empty
+func FilterTodosByText(todos []models.Todo, terms []string) []models.Todo {
+	if len(terms) == 0 {
+		return todos
+	}
+
+	filtered := []models.Todo{}
+	for _, todo := range todos {
+		if containsAllTerms(todo.Title+"\n"+todo.Notes, terms) {
+			filtered = append(filtered, todo)
+		}
+	}
+
+	return filtered
+}
+
+// FilterEntriesByText filters entries to those containing ALL terms in their title or body
+func FilterEntriesByText(entries []models.Entry, terms []string) []models.Entry {
+	if len(terms) == 0 {
+		return entries
+	}
+
+	filtered := []models.Entry{}
+	for _, entry := range entries {
+		if containsAllTerms(entry.Title+"\n"+entry.Body, terms) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// containsAllTerms reports whether text contains every term (case-insensitive)
+func containsAllTerms(text string, terms []string) bool {
+	text = strings.ToLower(text)
+	for _, term := range terms {
+		if !strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestChecklistProgress(t *testing.T) {
+	notes := "Acceptance:\n- [x] login works\n  - [ ] logout works\n* [X] docs\n- [] not an item\n- plain bullet"
+
+	done, total := ChecklistProgress(notes)
+	if done != 2 || total != 3 {
+		t.Errorf("ChecklistProgress() = %d/%d, want 2/3", done, total)
+	}
+
+	if done, total := ChecklistProgress(""); done != 0 || total != 0 {
+		t.Errorf("Expected 0/0 for empty notes, got %d/%d", done, total)
+	}
+}
+
+func TestFilterTodosByText(t *testing.T) {
+	todos := []models.Todo{
+		{ID: "1", Title: "Deploy api", Notes: "run kubectl rollout restart"},
+		{ID: "2", Title: "Rollout plan"},
+		{ID: "3", Title: "Write docs", Notes: "see wiki"},
+	}
+
+	got := FilterTodosByText(todos, []string{"ROLLOUT"})
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "2" {
+		t.Errorf("Expected todos 1 and 2 (title or notes match), got %v", got)
+	}
+
+	got = FilterTodosByText(todos, []string{"rollout", "kubectl"})
+	if len(got) != 1 || got[0].ID != "1" {
+		t.Errorf("Expected only todo 1 (AND logic), got %v", got)
+	}
+
+	if got := FilterTodosByText(todos, nil); len(got) != 3 {
+		t.Errorf("Expected all todos without terms, got %d", len(got))
+	}
+}
+
+func TestFilterEntriesByText(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Title: "Standup", Body: "Discussed the outage"},
+		{ID: "2", Title: "Outage retro"},
+		{ID: "3", Title: "Lunch"},
+	}
+
+	got := FilterEntriesByText(entries, []string{"outage"})
+	if len(got) != 2 {
+		t.Errorf("Expected 2 entries matching title or body, got %v", got)
+	}
+}
@@ -0,0 +1,188 @@
+package helpers
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// personPattern matches +name mentions at the start of text or after whitespace/opening punctuation
+// Names start with a letter so "+1" and "a+b" are not treated as people
+var personPattern = regexp.MustCompile(`(?:^|[\s(\[])\+([a-zA-Z][a-zA-Z0-9_-]*)`)
+
+// ExtractPeople finds all +name mentions in text and returns them lowercase (without +)
+func ExtractPeople(text string) []string {
+	matches := personPattern.FindAllStringSubmatch(text, -1)
+
+	// Use map to deduplicate, slice to keep first-mention order
+	seen := make(map[string]bool)
+	people := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if len(match) > 1 {
+			person := strings.ToLower(match[1]) // Case-insensitive
+			if !seen[person] {
+				seen[person] = true
+				people = append(people, person)
+			}
+		}
+	}
+
+	return people
+}
+
+// ExtractUniquePeopleFromAll collects all unique people from entries and todos
+// Returns people sorted alphabetically with + prefix
+func ExtractUniquePeopleFromAll(entries []models.Entry, todos []models.Todo) []string {
+	peopleMap := make(map[string]bool)
+
+	for _, entry := range entries {
+		for _, person := range entry.People {
+			peopleMap[person] = true
+		}
+	}
+	for _, todo := range todos {
+		for _, person := range todo.People {
+			peopleMap[person] = true
+		}
+	}
+
+	people := make([]string, 0, len(peopleMap))
+	for person := range peopleMap {
+		people = append(people, "+"+person)
+	}
+	sort.Strings(people)
+
+	return people
+}
+
+// hasAllPeople reports whether itemPeople contains every (normalized) filter person
+func hasAllPeople(itemPeople []string, normalizedFilters []string) bool {
+	for _, filterPerson := range normalizedFilters {
+		found := false
+		for _, person := range itemPeople {
+			if strings.ToLower(person) == filterPerson {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizePeopleFilters removes + prefixes and lowercases filter people
+func normalizePeopleFilters(filterPeople []string) []string {
+	normalized := make([]string, len(filterPeople))
+	for i, person := range filterPeople {
+		normalized[i] = strings.ToLower(strings.TrimPrefix(person, "+"))
+	}
+	return normalized
+}
+
+// FilterEntriesByPeople filters entries to only those mentioning ALL specified people (AND logic)
+// People should be provided with + prefix (e.g., ["+alice", "+bob"])
+// Returns filtered list or original list if filterPeople is empty
+func FilterEntriesByPeople(entries []models.Entry, filterPeople []string) []models.Entry {
+	if len(filterPeople) == 0 {
+		return entries
+	}
+
+	normalizedFilters := normalizePeopleFilters(filterPeople)
+
+	filtered := []models.Entry{}
+	for _, entry := range entries {
+		if hasAllPeople(entry.People, normalizedFilters) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// FilterTodosByPeople filters todos to only those mentioning ALL specified people (AND logic)
+// People should be provided with + prefix (e.g., ["+alice", "+bob"])
+// Returns filtered list or original list if filterPeople is empty
+func FilterTodosByPeople(todos []models.Todo, filterPeople []string) []models.Todo {
+	if len(filterPeople) == 0 {
+		return todos
+	}
+
+	normalizedFilters := normalizePeopleFilters(filterPeople)
+
+	filtered := []models.Todo{}
+	for _, todo := range todos {
+		if hasAllPeople(todo.People, normalizedFilters) {
+			filtered = append(filtered, todo)
+		}
+	}
+
+	return filtered
+}
+
+// PersonSummary holds everything known about one person for the people view
+type PersonSummary struct {
+	Name          string        // Without + prefix
+	LastMentioned time.Time     // Most recent entry timestamp or todo creation
+	EntryCount    int           // Entries mentioning this person
+	TodoCount     int           // Todos mentioning this person (any status)
+	OpenTodos     []models.Todo // Todos not yet done, sorted for display
+}
+
+// SummarizePeople builds a summary per mentioned person
+// Returns people sorted by most recently mentioned first, then alphabetically
+func SummarizePeople(entries []models.Entry, todos []models.Todo) []PersonSummary {
+	summaries := make(map[string]*PersonSummary)
+
+	get := func(name string) *PersonSummary {
+		name = strings.ToLower(name)
+		if summaries[name] == nil {
+			summaries[name] = &PersonSummary{Name: name}
+		}
+		return summaries[name]
+	}
+
+	touch := func(s *PersonSummary, t time.Time) {
+		if t.After(s.LastMentioned) {
+			s.LastMentioned = t
+		}
+	}
+
+	for _, entry := range entries {
+		for _, person := range entry.People {
+			s := get(person)
+			s.EntryCount++
+			touch(s, entry.Timestamp)
+		}
+	}
+
+	for _, todo := range todos {
+		for _, person := range todo.People {
+			s := get(person)
+			s.TodoCount++
+			touch(s, todo.CreatedAt)
+			if todo.Status != "done" {
+				s.OpenTodos = append(s.OpenTodos, todo)
+			}
+		}
+	}
+
+	result := make([]PersonSummary, 0, len(summaries))
+	for _, s := range summaries {
+		s.OpenTodos = SortTodosForDisplay(s.OpenTodos)
+		result = append(result, *s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].LastMentioned.Equal(result[j].LastMentioned) {
+			return result[i].LastMentioned.After(result[j].LastMentioned)
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
@@ -0,0 +1,144 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestExtractPeople(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "single person",
+			text: "1:1 with +alice about roadmap",
+			want: []string{"alice"},
+		},
+		{
+			name: "multiple people in mention order",
+			text: "+Bob and +alice reviewed; +bob agreed",
+			want: []string{"bob", "alice"},
+		},
+		{
+			name: "person inside parentheses",
+			text: "Sync (+carol) tomorrow",
+			want: []string{"carol"},
+		},
+		{
+			name: "numbers and inline plus are not people",
+			text: "Scored +1 on a+b review",
+			want: []string{},
+		},
+		{
+			name: "person at line start",
+			text: "Notes\n+dave owns the deploy",
+			want: []string{"dave"},
+		},
+		{
+			name: "people and tags side by side",
+			text: "@work +erin",
+			want: []string{"erin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractPeople(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractPeople() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractUniquePeopleFromAll(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", People: []string{"bob", "alice"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", People: []string{"alice", "carol"}},
+	}
+
+	got := ExtractUniquePeopleFromAll(entries, todos)
+	want := []string{"+alice", "+bob", "+carol"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractUniquePeopleFromAll() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterByPeople(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Title: "With Alice", People: []string{"alice"}},
+		{ID: "2", Title: "With Alice and Bob", People: []string{"alice", "bob"}},
+		{ID: "3", Title: "Solo"},
+	}
+	todos := []models.Todo{
+		{ID: "1", Title: "Ask Bob", People: []string{"bob"}},
+		{ID: "2", Title: "Solo"},
+	}
+
+	got := FilterEntriesByPeople(entries, []string{"+alice"})
+	if len(got) != 2 {
+		t.Errorf("FilterEntriesByPeople(+alice) returned %d entries, want 2", len(got))
+	}
+
+	got = FilterEntriesByPeople(entries, []string{"+Alice", "+bob"})
+	if len(got) != 1 || got[0].ID != "2" {
+		t.Errorf("FilterEntriesByPeople(+alice +bob) = %v, want entry 2", got)
+	}
+
+	if got := FilterEntriesByPeople(entries, nil); len(got) != 3 {
+		t.Errorf("FilterEntriesByPeople(nil) returned %d entries, want 3", len(got))
+	}
+
+	gotTodos := FilterTodosByPeople(todos, []string{"+bob"})
+	if len(gotTodos) != 1 || gotTodos[0].ID != "1" {
+		t.Errorf("FilterTodosByPeople(+bob) = %v, want todo 1", gotTodos)
+	}
+}
+
+func TestSummarizePeople(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	entries := []models.Entry{
+		{ID: "e1", People: []string{"alice"}, Timestamp: now.AddDate(0, 0, -10)},
+		{ID: "e2", People: []string{"alice", "bob"}, Timestamp: now.AddDate(0, 0, -3)},
+	}
+	todos := []models.Todo{
+		{ID: "t1", Title: "Send deck", Status: "open", People: []string{"alice"}, CreatedAt: now.AddDate(0, 0, -1)},
+		{ID: "t2", Title: "Book room", Status: "done", People: []string{"alice"}, CreatedAt: now.AddDate(0, 0, -5)},
+		{ID: "t3", Title: "Prep agenda", Status: "next", People: []string{"alice"}, CreatedAt: now.AddDate(0, 0, -7)},
+	}
+
+	got := SummarizePeople(entries, todos)
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 people, got %d", len(got))
+	}
+
+	// Most recently mentioned first
+	alice := got[0]
+	if alice.Name != "alice" {
+		t.Fatalf("Expected alice first, got %s", alice.Name)
+	}
+	if !alice.LastMentioned.Equal(now.AddDate(0, 0, -1)) {
+		t.Errorf("alice LastMentioned = %v, want %v", alice.LastMentioned, now.AddDate(0, 0, -1))
+	}
+	if alice.EntryCount != 2 || alice.TodoCount != 3 {
+		t.Errorf("alice counts = %d entries, %d todos; want 2, 3", alice.EntryCount, alice.TodoCount)
+	}
+	// Open todos exclude done, next sorted before open
+	if len(alice.OpenTodos) != 2 || alice.OpenTodos[0].ID != "t3" || alice.OpenTodos[1].ID != "t1" {
+		t.Errorf("alice OpenTodos = %v, want [t3 t1]", alice.OpenTodos)
+	}
+
+	bob := got[1]
+	if bob.Name != "bob" || bob.EntryCount != 1 || len(bob.OpenTodos) != 0 {
+		t.Errorf("bob summary = %+v", bob)
+	}
+}
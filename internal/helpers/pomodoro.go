@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// Focus mode interval lengths used when config.json doesn't set them
+const (
+	DefaultPomodoroWorkMinutes  = 25
+	DefaultPomodoroBreakMinutes = 5
+)
+
+// PomodoroLengths returns the focus mode work and break intervals (zero or negative config = default)
+func PomodoroLengths(cfg models.Config) (work, rest time.Duration) {
+	workMinutes, breakMinutes := cfg.PomodoroWorkMinutes, cfg.PomodoroBreakMinutes
+	if workMinutes <= 0 {
+		workMinutes = DefaultPomodoroWorkMinutes
+	}
+	if breakMinutes <= 0 {
+		breakMinutes = DefaultPomodoroBreakMinutes
+	}
+	return time.Duration(workMinutes) * time.Minute, time.Duration(breakMinutes) * time.Minute
+}
+
+// CountPomodorosBetween counts pomodoros completed in [start, end) across todos
+func CountPomodorosBetween(todos []models.Todo, start, end time.Time) int {
+	count := 0
+	for _, todo := range todos {
+		for _, at := range todo.Pomodoros {
+			if !at.Before(start) && at.Before(end) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// FormatCountdown formats the time left in a focus interval as minutes and seconds, e.g. "24:59"
+// Rounds up so the clock shows "0:00" only when the interval is over
+func FormatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	seconds := int((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestPomodoroLengths(t *testing.T) {
+	work, rest := PomodoroLengths(models.Config{})
+	if work != 25*time.Minute || rest != 5*time.Minute {
+		t.Errorf("Expected defaults 25m/5m, got %v/%v", work, rest)
+	}
+
+	work, rest = PomodoroLengths(models.Config{PomodoroWorkMinutes: 50, PomodoroBreakMinutes: -1})
+	if work != 50*time.Minute || rest != 5*time.Minute {
+		t.Errorf("Expected 50m/5m, got %v/%v", work, rest)
+	}
+}
+
+func TestCountPomodorosBetween(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	todos := []models.Todo{
+		{ID: "1", Pomodoros: []time.Time{day.Add(9 * time.Hour), day.Add(10 * time.Hour)}},
+		{ID: "2", Pomodoros: []time.Time{day.Add(-time.Hour), day.Add(24 * time.Hour)}},
+	}
+
+	if got := CountPomodorosBetween(todos, day, day.AddDate(0, 0, 1)); got != 2 {
+		t.Errorf("CountPomodorosBetween() = %d, want 2 (end is exclusive)", got)
+	}
+}
+
+func TestFormatCountdown(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{25 * time.Minute, "25:00"},
+		{24*time.Minute + 59*time.Second + 100*time.Millisecond, "25:00"},
+		{61 * time.Second, "1:01"},
+		{0, "0:00"},
+		{-time.Second, "0:00"},
+	}
+	for _, tt := range tests {
+		if got := FormatCountdown(tt.in); got != tt.want {
+			t.Errorf("FormatCountdown(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import "github.com/apodacaa/amos/internal/models"
+
+// PrivateTag marks an entry as private (@private, or nested like @private/health):
+// hidden on screen until revealed and left out of exports unless asked for
+const PrivateTag = "private"
+
+// IsPrivateTagged reports whether tags mark an entry as private
+func IsPrivateTagged(tags []string) bool {
+	for _, tag := range tags {
+		if TagMatches(tag, PrivateTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPrivateEntry reports whether an entry is private (flagged on save, or tagged by hand)
+func IsPrivateEntry(entry models.Entry) bool {
+	return entry.Private || IsPrivateTagged(entry.Tags)
+}
+
+// WithoutPrivateEntries returns entries minus the private ones
+func WithoutPrivateEntries(entries []models.Entry) []models.Entry {
+	filtered := []models.Entry{}
+	for _, entry := range entries {
+		if !IsPrivateEntry(entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// WithoutPrivateTodos returns todos minus those extracted from private entries
+// (their titles are lines of the private body)
+func WithoutPrivateTodos(todos []models.Todo, entries []models.Entry) []models.Todo {
+	private := map[string]bool{}
+	for _, entry := range entries {
+		if IsPrivateEntry(entry) {
+			private[entry.ID] = true
+		}
+	}
+
+	filtered := []models.Todo{}
+	for _, todo := range todos {
+		if todo.EntryID == nil || !private[*todo.EntryID] {
+			filtered = append(filtered, todo)
+		}
+	}
+	return filtered
+}
+
+// RedactPrivateEntry returns a private entry with its title, body, tags and people blanked
+// (date and linked todo IDs kept); other entries are returned unchanged
+func RedactPrivateEntry(entry models.Entry) models.Entry {
+	if !IsPrivateEntry(entry) {
+		return entry
+	}
+	entry.Title = "(private)"
+	entry.Body = ""
+	entry.Tags = nil
+	entry.People = nil
+	return entry
+}
+
+// RedactPrivateEntries applies RedactPrivateEntry to every entry (the input is not modified)
+func RedactPrivateEntries(entries []models.Entry) []models.Entry {
+	redacted := make([]models.Entry, len(entries))
+	for i, entry := range entries {
+		redacted[i] = RedactPrivateEntry(entry)
+	}
+	return redacted
+}
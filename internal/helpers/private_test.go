@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestIsPrivateEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry models.Entry
+		want  bool
+	}{
+		{"flagged", models.Entry{Private: true}, true},
+		{"tagged by hand", models.Entry{Tags: []string{"work", "private"}}, true},
+		{"nested tag", models.Entry{Tags: []string{"private/health"}}, true},
+		{"similar tag", models.Entry{Tags: []string{"privateer"}}, false},
+		{"plain", models.Entry{Tags: []string{"work"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsPrivateEntry(tt.entry); got != tt.want {
+			t.Errorf("%s: IsPrivateEntry() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWithoutPrivate(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "public", Title: "Standup"},
+		{ID: "secret", Title: "HR meeting", Private: true},
+	}
+	secretID, publicID := "secret", "public"
+	todos := []models.Todo{
+		{ID: "1", Title: "Standalone"},
+		{ID: "2", Title: "From standup", EntryID: &publicID},
+		{ID: "3", Title: "Follow up with HR", EntryID: &secretID},
+	}
+
+	if got := WithoutPrivateEntries(entries); len(got) != 1 || got[0].ID != "public" {
+		t.Errorf("WithoutPrivateEntries() = %v, want only the public entry", got)
+	}
+	got := WithoutPrivateTodos(todos, entries)
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "2" {
+		t.Errorf("WithoutPrivateTodos() = %v, want todos 1 and 2", got)
+	}
+}
+
+func TestRedactPrivateEntries(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Title: "Standup", Tags: []string{"work"}},
+		{ID: "2", Title: "Doctor", Body: "Results", Tags: []string{"private"}, People: []string{"drsmith"}},
+	}
+
+	redacted := RedactPrivateEntries(entries)
+	if redacted[0].Title != "Standup" {
+		t.Errorf("Expected the public entry unchanged, got %+v", redacted[0])
+	}
+	if r := redacted[1]; r.Title != "(private)" || r.Body != "" || r.Tags != nil || r.People != nil {
+		t.Errorf("Expected the private entry blanked, got %+v", r)
+	}
+	if entries[1].Title != "Doctor" {
+		t.Error("Expected the input not to be modified")
+	}
+}
+
+func TestRenameTagUpdatesPrivacy(t *testing.T) {
+	entries := []models.Entry{{ID: "1", Title: "Checkup @health", Tags: []string{"health"}}}
+
+	updated, _ := RenameTagInEntries(entries, "health", "private")
+	if !updated[0].Private {
+		t.Error("Expected merging into @private to make the entry private")
+	}
+	updated, _ = RenameTagInEntries(updated, "private", "health")
+	if updated[0].Private {
+		t.Error("Expected renaming @private away to make the entry public")
+	}
+}
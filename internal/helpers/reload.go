@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"encoding/json"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// TodoChangedOnDisk reports whether a todo held in memory was changed or removed in a fresh load
+// Compared as JSON (what's on disk), so in-memory details like monotonic clock readings don't count
+func TodoChangedOnDisk(inMemory, onDisk []models.Todo, id string) bool {
+	before := TodoIndexByID(inMemory, id)
+	if before < 0 {
+		return false
+	}
+	after := TodoIndexByID(onDisk, id)
+	if after < 0 {
+		return true
+	}
+	return !sameJSON(inMemory[before], onDisk[after])
+}
+
+// EntryChangedOnDisk reports whether an entry held in memory was changed or removed in a fresh load
+func EntryChangedOnDisk(inMemory, onDisk []models.Entry, id string) bool {
+	before := EntryIndexByID(inMemory, id)
+	if before < 0 {
+		return false
+	}
+	after := EntryIndexByID(onDisk, id)
+	if after < 0 {
+		return true
+	}
+	return !sameJSON(inMemory[before], onDisk[after])
+}
+
+// TodoIndexByID returns the index of the todo with the given ID (-1 if missing)
+func TodoIndexByID(todos []models.Todo, id string) int {
+	for i, todo := range todos {
+		if todo.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// EntryIndexByID returns the index of the entry with the given ID (-1 if missing)
+func EntryIndexByID(entries []models.Entry, id string) int {
+	for i, entry := range entries {
+		if entry.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameJSON reports whether a and b serialize identically
+func sameJSON(a, b any) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aJSON) == string(bJSON)
+}
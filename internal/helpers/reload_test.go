@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestTodoChangedOnDisk(t *testing.T) {
+	// time.Now() carries a monotonic reading that a JSON round trip drops
+	now := time.Now()
+	parsed, _ := time.Parse(time.RFC3339Nano, now.Format(time.RFC3339Nano))
+
+	inMemory := []models.Todo{
+		{ID: "1", Title: "Same", Status: "open", CreatedAt: now},
+		{ID: "2", Title: "Edited", Status: "open"},
+		{ID: "3", Title: "Removed", Status: "open"},
+	}
+	onDisk := []models.Todo{
+		{ID: "1", Title: "Same", Status: "open", CreatedAt: parsed},
+		{ID: "2", Title: "Edited", Status: "done"},
+		{ID: "4", Title: "Added", Status: "open"},
+	}
+
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"1", false},
+		{"2", true},
+		{"3", true},
+		{"4", false}, // Not in memory: nothing to conflict with
+		{"missing", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := TodoChangedOnDisk(inMemory, onDisk, tt.id); got != tt.want {
+				t.Errorf("TodoChangedOnDisk(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryChangedOnDisk(t *testing.T) {
+	inMemory := []models.Entry{{ID: "1", Title: "Same"}, {ID: "2", Title: "Before"}}
+	onDisk := []models.Entry{{ID: "1", Title: "Same"}, {ID: "2", Title: "After"}}
+
+	if EntryChangedOnDisk(inMemory, onDisk, "1") {
+		t.Error("Expected unchanged entry 1")
+	}
+	if !EntryChangedOnDisk(inMemory, onDisk, "2") {
+		t.Error("Expected changed entry 2")
+	}
+	if !EntryChangedOnDisk(inMemory, onDisk[:1], "2") {
+		t.Error("Expected removed entry 2 to count as changed")
+	}
+}
+
+func TestIndexByID(t *testing.T) {
+	todos := []models.Todo{{ID: "a"}, {ID: "b"}}
+	if got := TodoIndexByID(todos, "b"); got != 1 {
+		t.Errorf("TodoIndexByID(b) = %d, want 1", got)
+	}
+	if got := TodoIndexByID(todos, "z"); got != -1 {
+		t.Errorf("TodoIndexByID(z) = %d, want -1", got)
+	}
+
+	entries := []models.Entry{{ID: "a"}, {ID: "b"}}
+	if got := EntryIndexByID(entries, "a"); got != 0 {
+		t.Errorf("EntryIndexByID(a) = %d, want 0", got)
+	}
+	if got := EntryIndexByID(entries, "z"); got != -1 {
+		t.Errorf("EntryIndexByID(z) = %d, want -1", got)
+	}
+}
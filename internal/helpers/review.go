@@ -0,0 +1,235 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// StaleNextAge is how long a todo can sit in "next" before the weekly review flags it
+const StaleNextAge = 7 * 24 * time.Hour
+
+// TagTodos groups todos under a single tag
+type TagTodos struct {
+	Tag   string // Tag with @ prefix, or "(untagged)"
+	Todos []models.Todo
+}
+
+// WeeklyReview holds everything written, created and finished during one ISO week
+type WeeklyReview struct {
+	Year      int
+	Week      int
+	Start     time.Time      // Monday 00:00
+	End       time.Time      // Sunday 23:59:59.999999999
+	Entries   []models.Entry // Entries written this week (oldest first)
+	Completed []models.Todo  // Todos completed this week
+	Created   []models.Todo  // Todos created this week
+	OpenByTag []TagTodos     // Todos still open at the end of the week, grouped by tag
+	StaleNext []models.Todo  // "next" todos created more than StaleNextAge before the week ended
+}
+
+// WeekID formats an ISO week as "2026-W42"
+func WeekID(year, week int) string {
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// isoWeekPattern is an ISO week ID and nothing else ("2026-W42", not "2026-W42x" or "2026-W4")
+var isoWeekPattern = regexp.MustCompile(`^(\d{4})-W(\d{2})$`)
+
+// ParseISOWeek parses "2026-W42" (case-insensitive W) into year and week
+func ParseISOWeek(s string) (year, week int, err error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	match := isoWeekPattern.FindStringSubmatch(s)
+	if match == nil {
+		return 0, 0, fmt.Errorf("invalid ISO week %q (want e.g. 2026-W42)", s)
+	}
+	year, _ = strconv.Atoi(match[1]) // Digits only, can't fail
+	week, _ = strconv.Atoi(match[2])
+
+	// Reject weeks the year doesn't have (most years have 52, some 53)
+	_, weeksInYear := GetISOWeek(time.Date(year, 12, 28, 0, 0, 0, 0, time.UTC))
+	if week < 1 || week > weeksInYear {
+		return 0, 0, fmt.Errorf("invalid ISO week %q (%d has %d weeks)", s, year, weeksInYear)
+	}
+
+	return year, week, nil
+}
+
+// ISOWeekStart returns midnight on the Monday of the given ISO week in loc
+func ISOWeekStart(year, week int, loc *time.Location) time.Time {
+	// January 4th is always in ISO week 1
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	weekdayIdx := (int(jan4.Weekday()) + 6) % 7 // Monday=0 ... Sunday=6
+	week1Monday := jan4.AddDate(0, 0, -weekdayIdx)
+	return week1Monday.AddDate(0, 0, 7*(week-1))
+}
+
+// ShiftISOWeek moves an ISO week by delta weeks (negative = earlier)
+func ShiftISOWeek(year, week, delta int) (int, int) {
+	monday := ISOWeekStart(year, week, time.UTC).AddDate(0, 0, 7*delta)
+	return GetISOWeek(monday)
+}
+
+// BuildWeeklyReview compiles the review for one ISO week using GetISOWeek semantics
+func BuildWeeklyReview(entries []models.Entry, todos []models.Todo, year, week int, loc *time.Location) WeeklyReview {
+	start := ISOWeekStart(year, week, loc)
+	end := start.AddDate(0, 0, 7).Add(-time.Nanosecond)
+
+	review := WeeklyReview{
+		Year:      year,
+		Week:      week,
+		Start:     start,
+		End:       end,
+		Entries:   []models.Entry{},
+		Completed: []models.Todo{},
+		Created:   []models.Todo{},
+		OpenByTag: []TagTodos{},
+		StaleNext: []models.Todo{},
+	}
+
+	for _, entry := range entries {
+		if inWindow(entry.Timestamp, start, end) {
+			review.Entries = append(review.Entries, entry)
+		}
+	}
+	sort.SliceStable(review.Entries, func(i, j int) bool {
+		return review.Entries[i].Timestamp.Before(review.Entries[j].Timestamp)
+	})
+
+	openByTag := make(map[string][]models.Todo)
+	for _, todo := range todos {
+		if inWindow(todo.CreatedAt, start, end) {
+			review.Created = append(review.Created, todo)
+		}
+
+		if todo.Status == "done" {
+			// Legacy done todos (no CompletedAt) count as completed when created
+			completedAt := todo.CreatedAt
+			if todo.CompletedAt != nil {
+				completedAt = *todo.CompletedAt
+			}
+			if inWindow(completedAt, start, end) {
+				review.Completed = append(review.Completed, todo)
+			}
+		}
+
+		// Still open at the end of the week (and existed by then)
+		if todo.CreatedAt.After(end) || completedBy(todo, end) {
+			continue
+		}
+		// Archived unfinished todos were put away, not left open
+		if todo.ArchivedAt != nil && !todo.ArchivedAt.After(end) {
+			continue
+		}
+		if len(todo.Tags) == 0 {
+			openByTag["(untagged)"] = append(openByTag["(untagged)"], todo)
+		}
+		for _, tag := range todo.Tags {
+			openByTag["@"+tag] = append(openByTag["@"+tag], todo)
+		}
+
+		if todo.Status == "next" && end.Sub(todo.CreatedAt) > StaleNextAge {
+			review.StaleNext = append(review.StaleNext, todo)
+		}
+	}
+
+	// Tags alphabetically, untagged last
+	tags := make([]string, 0, len(openByTag))
+	for tag := range openByTag {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if (tags[i] == "(untagged)") != (tags[j] == "(untagged)") {
+			return tags[j] == "(untagged)"
+		}
+		return tags[i] < tags[j]
+	})
+	for _, tag := range tags {
+		review.OpenByTag = append(review.OpenByTag, TagTodos{Tag: tag, Todos: SortTodosForDisplay(openByTag[tag])})
+	}
+
+	review.Created = SortTodosForDisplay(review.Created)
+	review.Completed = SortTodosForDisplay(review.Completed)
+	sort.SliceStable(review.StaleNext, func(i, j int) bool {
+		return review.StaleNext[i].CreatedAt.Before(review.StaleNext[j].CreatedAt)
+	})
+
+	return review
+}
+
+// FormatReviewMarkdown renders a weekly review as a Markdown document
+func FormatReviewMarkdown(review WeeklyReview) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Weekly Review %s\n\n", WeekID(review.Year, review.Week))
+	fmt.Fprintf(&b, "%s to %s\n\n", review.Start.Format("Mon 2006-01-02"), review.End.Format("Mon 2006-01-02"))
+	fmt.Fprintf(&b, "%d entries, %d todos created, %d completed, %d stale next\n",
+		len(review.Entries), len(review.Created), len(review.Completed), len(review.StaleNext))
+
+	todoLine := func(todo models.Todo) string {
+		checkbox := "[ ]"
+		if todo.Status == "done" {
+			checkbox = "[x]"
+		}
+		line := fmt.Sprintf("- %s %s", checkbox, todo.Title)
+		if todo.Status == "next" {
+			line += " (next)"
+		}
+		return line + "\n"
+	}
+
+	b.WriteString("\n## Entries\n\n")
+	if len(review.Entries) == 0 {
+		b.WriteString("_None_\n")
+	}
+	for _, entry := range review.Entries {
+		fmt.Fprintf(&b, "- %s %s", entry.Timestamp.Format("Mon 2006-01-02"), entry.Title)
+		for _, tag := range entry.Tags {
+			b.WriteString(" @" + tag)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n## Completed\n\n")
+	if len(review.Completed) == 0 {
+		b.WriteString("_None_\n")
+	}
+	for _, todo := range review.Completed {
+		b.WriteString(todoLine(todo))
+	}
+
+	b.WriteString("\n## Created\n\n")
+	if len(review.Created) == 0 {
+		b.WriteString("_None_\n")
+	}
+	for _, todo := range review.Created {
+		b.WriteString(todoLine(todo))
+	}
+
+	b.WriteString("\n## Still Open\n")
+	if len(review.OpenByTag) == 0 {
+		b.WriteString("\n_None_\n")
+	}
+	for _, group := range review.OpenByTag {
+		fmt.Fprintf(&b, "\n### %s (%d)\n\n", group.Tag, len(group.Todos))
+		for _, todo := range group.Todos {
+			b.WriteString(todoLine(todo))
+		}
+	}
+
+	b.WriteString("\n## Stale Next\n\n")
+	if len(review.StaleNext) == 0 {
+		b.WriteString("_None_\n")
+	}
+	for _, todo := range review.StaleNext {
+		days := int(review.End.Sub(todo.CreatedAt).Hours() / 24)
+		fmt.Fprintf(&b, "- [ ] %s (%d days)\n", todo.Title, days)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestParseISOWeek(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantYear int
+		wantWeek int
+		wantErr  bool
+	}{
+		{"2026-W42", 2026, 42, false},
+		{"2026-w01", 2026, 1, false},
+		{"2026-W53", 2026, 53, false}, // 2026 has 53 ISO weeks
+		{"2025-W53", 0, 0, true},      // 2025 has 52
+		{"2026-W00", 0, 0, true},
+		{"2026-42", 0, 0, true},
+		{"2026-W12junk", 0, 0, true},
+		{"2026-W1", 0, 0, true},
+		{"+2026-W12", 0, 0, true},
+		{"", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		year, week, err := ParseISOWeek(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseISOWeek(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if year != tt.wantYear || week != tt.wantWeek {
+			t.Errorf("ParseISOWeek(%q) = %d, %d, want %d, %d", tt.input, year, week, tt.wantYear, tt.wantWeek)
+		}
+	}
+}
+
+func TestISOWeekStartMatchesGetISOWeek(t *testing.T) {
+	tests := []struct {
+		year, week int
+		want       string
+	}{
+		{2026, 42, "2026-10-12"},
+		{2026, 1, "2025-12-29"}, // Week 1 starts in the previous year
+		{2021, 1, "2021-01-04"},
+		{2020, 53, "2020-12-28"},
+	}
+
+	for _, tt := range tests {
+		start := ISOWeekStart(tt.year, tt.week, time.UTC)
+		if got := start.Format("2006-01-02"); got != tt.want {
+			t.Errorf("ISOWeekStart(%d, %d) = %s, want %s", tt.year, tt.week, got, tt.want)
+		}
+		if y, w := GetISOWeek(start); y != tt.year || w != tt.week {
+			t.Errorf("GetISOWeek(ISOWeekStart(%d, %d)) = %d, %d", tt.year, tt.week, y, w)
+		}
+	}
+}
+
+func TestShiftISOWeek(t *testing.T) {
+	if y, w := ShiftISOWeek(2026, 1, -1); y != 2025 || w != 52 {
+		t.Errorf("ShiftISOWeek(2026, 1, -1) = %d, %d, want 2025, 52", y, w)
+	}
+	if y, w := ShiftISOWeek(2026, 53, 1); y != 2027 || w != 1 {
+		t.Errorf("ShiftISOWeek(2026, 53, 1) = %d, %d, want 2027, 1", y, w)
+	}
+}
+
+func TestBuildWeeklyReview(t *testing.T) {
+	// ISO week 2026-W42 runs Mon 2026-10-12 to Sun 2026-10-18
+	monday := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	doneAt := monday.AddDate(0, 0, 2)
+	lateDone := monday.AddDate(0, 0, 10)
+	archivedAt := monday.AddDate(0, 0, 3)
+
+	entries := []models.Entry{
+		{ID: "e2", Title: "Friday notes", Timestamp: monday.AddDate(0, 0, 4)},
+		{ID: "e1", Title: "Monday notes", Timestamp: monday},
+		{ID: "e0", Title: "Last week", Timestamp: monday.AddDate(0, 0, -1)},
+		{ID: "e3", Title: "Next week", Timestamp: monday.AddDate(0, 0, 7)},
+	}
+	todos := []models.Todo{
+		{ID: "t1", Title: "Created and done", Status: "done", Tags: []string{"work"}, CreatedAt: monday, CompletedAt: &doneAt},
+		{ID: "t2", Title: "Created, still open", Status: "open", Tags: []string{"work"}, CreatedAt: monday.Add(time.Hour)},
+		{ID: "t3", Title: "Old next", Status: "next", CreatedAt: monday.AddDate(0, 0, -30)},
+		{ID: "t4", Title: "Fresh next", Status: "next", Tags: []string{"home"}, CreatedAt: monday.AddDate(0, 0, 1)},
+		{ID: "t5", Title: "Done after the week", Status: "done", Tags: []string{"work"}, CreatedAt: monday.AddDate(0, 0, -3), CompletedAt: &lateDone},
+		{ID: "t6", Title: "Created after the week", Status: "open", CreatedAt: monday.AddDate(0, 0, 8)},
+		{ID: "t7", Title: "Archived unfinished", Status: "open", Tags: []string{"errand"}, CreatedAt: monday.AddDate(0, 0, -5), ArchivedAt: &archivedAt},
+	}
+
+	review := BuildWeeklyReview(entries, todos, 2026, 42, time.UTC)
+
+	if len(review.Entries) != 2 || review.Entries[0].ID != "e1" || review.Entries[1].ID != "e2" {
+		t.Errorf("Expected entries [e1 e2] oldest first, got %v", review.Entries)
+	}
+
+	if len(review.Completed) != 1 || review.Completed[0].ID != "t1" {
+		t.Errorf("Expected completed [t1], got %v", review.Completed)
+	}
+
+	if len(review.Created) != 3 {
+		t.Errorf("Expected 3 created todos, got %d", len(review.Created))
+	}
+
+	// Open at end of week: t2, t5 (@work), t4 (@home), t3 (untagged); t7 was archived
+	wantGroups := []string{"@home", "@work", "(untagged)"}
+	if len(review.OpenByTag) != len(wantGroups) {
+		t.Fatalf("Expected %d open groups, got %v", len(wantGroups), review.OpenByTag)
+	}
+	for i, group := range review.OpenByTag {
+		if group.Tag != wantGroups[i] {
+			t.Errorf("OpenByTag[%d] = %s, want %s", i, group.Tag, wantGroups[i])
+		}
+	}
+	if len(review.OpenByTag[1].Todos) != 2 {
+		t.Errorf("Expected 2 open @work todos, got %d", len(review.OpenByTag[1].Todos))
+	}
+
+	if len(review.StaleNext) != 1 || review.StaleNext[0].ID != "t3" {
+		t.Errorf("Expected stale next [t3], got %v", review.StaleNext)
+	}
+}
+
+func TestFormatReviewMarkdown(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	entries := []models.Entry{{ID: "e1", Title: "Monday notes", Tags: []string{"work"}, Timestamp: monday}}
+	todos := []models.Todo{{ID: "t1", Title: "Ship it", Status: "open", Tags: []string{"work"}, CreatedAt: monday}}
+
+	md := FormatReviewMarkdown(BuildWeeklyReview(entries, todos, 2026, 42, time.UTC))
+
+	for _, want := range []string{
+		"# Weekly Review 2026-W42",
+		"Mon 2026-10-12 to Sun 2026-10-18",
+		"- Mon 2026-10-12 Monday notes @work",
+		"### @work (1)",
+		"- [ ] Ship it",
+		"## Stale Next\n\n_None_",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown missing %q:\n%s", want, md)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// schedulePattern matches due:<day> and sched:<day> tokens in todo titles
+var schedulePattern = regexp.MustCompile(`(?i)(?:^|\s)(due|sched):(\S+)`)
+
+// weekdayNames maps short and long weekday names to time.Weekday
+var weekdayNames = map[string]time.Weekday{
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+	"sun": time.Sunday, "sunday": time.Sunday,
+}
+
+// ParseDay resolves a day word relative to now and returns midnight of that day
+// Accepts "today", "tomorrow", "2006-01-02" and weekday names ("fri" = the next Friday, today included)
+func ParseDay(word string, now time.Time) (time.Time, bool) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	today := startOfDay(now)
+
+	switch word {
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+
+	if weekday, ok := weekdayNames[word]; ok {
+		days := (int(weekday) - int(today.Weekday()) + 7) % 7
+		return today.AddDate(0, 0, days), true
+	}
+
+	if day, err := time.ParseInLocation("2006-01-02", word, now.Location()); err == nil {
+		return day, true
+	}
+
+	return time.Time{}, false
+}
+
+// ExtractSchedule finds due:<day> and sched:<day> in text (last one wins)
+// Returns nil for anything not set or not parseable
+func ExtractSchedule(text string, now time.Time) (due, scheduled *time.Time) {
+	for _, match := range schedulePattern.FindAllStringSubmatch(text, -1) {
+		day, ok := ParseDay(match[2], now)
+		if !ok {
+			continue
+		}
+		if strings.EqualFold(match[1], "due") {
+			due = &day
+		} else {
+			scheduled = &day
+		}
+	}
+	return due, scheduled
+}
+
+// sameDay reports whether a and b fall on the same calendar day (in a's location)
+func sameDay(a, b time.Time) bool {
+	return dayKey(a) == dayKey(b.In(a.Location()))
+}
+
+// Agenda answers "what should I do today?"
+type Agenda struct {
+	Overdue       []models.Todo  // Not done, due before today
+	Today         []models.Todo  // Not done, due or scheduled today (or scheduled earlier and still open)
+	Next          []models.Todo  // Remaining "next" todos
+	FromYesterday []models.Todo  // "next" todos created yesterday and still unfinished
+	Entries       []models.Entry // Entries written today (oldest first)
+}
+
+// Todos returns all agenda todos in display order (Overdue, Today, Next, FromYesterday)
+func (a Agenda) Todos() []models.Todo {
+	todos := make([]models.Todo, 0, len(a.Overdue)+len(a.Today)+len(a.Next)+len(a.FromYesterday))
+	todos = append(todos, a.Overdue...)
+	todos = append(todos, a.Today...)
+	todos = append(todos, a.Next...)
+	todos = append(todos, a.FromYesterday...)
+	return todos
+}
+
+// BuildAgenda collects today's agenda; each todo appears in at most one section
+func BuildAgenda(entries []models.Entry, todos []models.Todo, now time.Time) Agenda {
+	today := startOfDay(now)
+	yesterday := today.AddDate(0, 0, -1)
+
+	agenda := Agenda{
+		Overdue:       []models.Todo{},
+		Today:         []models.Todo{},
+		Next:          []models.Todo{},
+		FromYesterday: []models.Todo{},
+		Entries:       []models.Entry{},
+	}
+
+	for _, todo := range SortTodosForDisplay(todos) {
+		if todo.Status == "done" {
+			continue
+		}
+		switch {
+		case todo.Due != nil && todo.Due.Before(today):
+			agenda.Overdue = append(agenda.Overdue, todo)
+		case todo.Due != nil && sameDay(today, *todo.Due),
+			todo.Scheduled != nil && !todo.Scheduled.After(today):
+			agenda.Today = append(agenda.Today, todo)
+		case todo.Status == "next" && sameDay(yesterday, todo.CreatedAt):
+			agenda.FromYesterday = append(agenda.FromYesterday, todo)
+		case todo.Status == "next":
+			agenda.Next = append(agenda.Next, todo)
+		}
+	}
+
+	for _, entry := range SortEntriesForDisplay(entries) {
+		if sameDay(today, entry.Timestamp) {
+			// Oldest first reads like the day's timeline
+			agenda.Entries = append([]models.Entry{entry}, agenda.Entries...)
+		}
+	}
+
+	return agenda
+}
@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestParseDay(t *testing.T) {
+	// Wednesday afternoon
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		word string
+		want string
+		ok   bool
+	}{
+		{"today", "2026-10-14", true},
+		{"Tomorrow", "2026-10-15", true},
+		{"fri", "2026-10-16", true},
+		{"wednesday", "2026-10-14", true}, // Today counts
+		{"mon", "2026-10-19", true},
+		{"2026-12-24", "2026-12-24", true},
+		{"someday", "", false},
+		{"2026-13-01", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseDay(tt.word, now)
+		if ok != tt.ok {
+			t.Errorf("ParseDay(%q) ok = %v, want %v", tt.word, ok, tt.ok)
+			continue
+		}
+		if ok && got.Format("2006-01-02") != tt.want {
+			t.Errorf("ParseDay(%q) = %s, want %s", tt.word, got.Format("2006-01-02"), tt.want)
+		}
+		if ok && (got.Hour() != 0 || got.Minute() != 0) {
+			t.Errorf("ParseDay(%q) = %v, want midnight", tt.word, got)
+		}
+	}
+}
+
+func TestExtractSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+	due, scheduled := ExtractSchedule("Send invoice due:fri sched:tomorrow @work", now)
+	if due == nil || due.Format("2006-01-02") != "2026-10-16" {
+		t.Errorf("Expected due 2026-10-16, got %v", due)
+	}
+	if scheduled == nil || scheduled.Format("2006-01-02") != "2026-10-15" {
+		t.Errorf("Expected scheduled 2026-10-15, got %v", scheduled)
+	}
+
+	due, scheduled = ExtractSchedule("Nothing here, not even overdue:today", now)
+	if due != nil || scheduled != nil {
+		t.Errorf("Expected no schedule, got due=%v scheduled=%v", due, scheduled)
+	}
+
+	due, _ = ExtractSchedule("Bad date due:nope", now)
+	if due != nil {
+		t.Errorf("Expected unparseable due to be ignored, got %v", due)
+	}
+}
+
+func TestBuildAgenda(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+	today := startOfDay(now)
+	yesterday := today.AddDate(0, 0, -1)
+	lastWeek := today.AddDate(0, 0, -7)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	todos := []models.Todo{
+		{ID: "overdue", Status: "open", Due: &yesterday, CreatedAt: lastWeek},
+		{ID: "overdue-next", Status: "next", Due: &lastWeek, CreatedAt: lastWeek},
+		{ID: "due-today", Status: "open", Due: &today, CreatedAt: lastWeek},
+		{ID: "sched-past", Status: "open", Scheduled: &yesterday, CreatedAt: lastWeek},
+		{ID: "sched-future", Status: "open", Scheduled: &tomorrow, CreatedAt: lastWeek},
+		{ID: "next", Status: "next", CreatedAt: lastWeek},
+		{ID: "next-yesterday", Status: "next", CreatedAt: yesterday.Add(9 * time.Hour)},
+		{ID: "done-due", Status: "done", Due: &yesterday, CreatedAt: lastWeek},
+		{ID: "plain", Status: "open", CreatedAt: yesterday},
+	}
+	entries := []models.Entry{
+		{ID: "late", Timestamp: today.Add(14 * time.Hour)},
+		{ID: "early", Timestamp: today.Add(8 * time.Hour)},
+		{ID: "old", Timestamp: yesterday},
+	}
+
+	agenda := BuildAgenda(entries, todos, now)
+
+	ids := func(todos []models.Todo) map[string]bool {
+		set := make(map[string]bool)
+		for _, todo := range todos {
+			set[todo.ID] = true
+		}
+		return set
+	}
+
+	overdue := ids(agenda.Overdue)
+	if len(overdue) != 2 || !overdue["overdue"] || !overdue["overdue-next"] {
+		t.Errorf("Unexpected overdue: %v", agenda.Overdue)
+	}
+	todaySet := ids(agenda.Today)
+	if len(todaySet) != 2 || !todaySet["due-today"] || !todaySet["sched-past"] {
+		t.Errorf("Unexpected today: %v", agenda.Today)
+	}
+	if len(agenda.Next) != 1 || agenda.Next[0].ID != "next" {
+		t.Errorf("Unexpected next: %v", agenda.Next)
+	}
+	if len(agenda.FromYesterday) != 1 || agenda.FromYesterday[0].ID != "next-yesterday" {
+		t.Errorf("Unexpected from yesterday: %v", agenda.FromYesterday)
+	}
+	if len(agenda.Todos()) != 6 {
+		t.Errorf("Expected 6 agenda todos, got %d", len(agenda.Todos()))
+	}
+
+	if len(agenda.Entries) != 2 || agenda.Entries[0].ID != "early" || agenda.Entries[1].ID != "late" {
+		t.Errorf("Expected today's entries [early late], got %v", agenda.Entries)
+	}
+}
@@ -3,6 +3,7 @@ package helpers
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/apodacaa/amos/internal/models"
@@ -148,3 +149,225 @@ func getLastNWeeks(weeks []WeekStats, n int) []WeekStats {
 
 	return result
 }
+
+// TagStats holds entry and todo counts for a single tag
+type TagStats struct {
+	Tag        string // Tag with @ prefix
+	EntryCount int
+	TodoCount  int
+}
+
+// AggregateByTopLevelTag rolls up entry and todo counts per top-level tag
+// "@client/acme" and "@client/globex" both count towards "@client" (once per item)
+// Returns tags sorted by total count (highest first), then alphabetically
+func AggregateByTopLevelTag(entries []models.Entry, todos []models.Todo) []TagStats {
+	statsMap := make(map[string]*TagStats)
+
+	get := func(tag string) *TagStats {
+		if statsMap[tag] == nil {
+			statsMap[tag] = &TagStats{Tag: "@" + tag}
+		}
+		return statsMap[tag]
+	}
+
+	for _, entry := range entries {
+		for _, tag := range topLevelTags(entry.Tags) {
+			get(tag).EntryCount++
+		}
+	}
+
+	for _, todo := range todos {
+		for _, tag := range topLevelTags(todo.Tags) {
+			get(tag).TodoCount++
+		}
+	}
+
+	result := make([]TagStats, 0, len(statsMap))
+	for _, stats := range statsMap {
+		result = append(result, *stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		ti := result[i].EntryCount + result[i].TodoCount
+		tj := result[j].EntryCount + result[j].TodoCount
+		if ti != tj {
+			return ti > tj
+		}
+		return result[i].Tag < result[j].Tag
+	})
+
+	return result
+}
+
+// topLevelTags returns the distinct lowercase root segments of the given tags
+func topLevelTags(tags []string) []string {
+	seen := make(map[string]bool)
+	var roots []string
+	for _, tag := range tags {
+		root := strings.ToLower(TopLevelTag(tag))
+		if !seen[root] {
+			seen[root] = true
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// StatsWindowWeeks are the selectable stats view windows (in weeks)
+var StatsWindowWeeks = []int{4, 13, 52}
+
+// WindowStart returns midnight on the Monday that starts a window of the given
+// number of ISO weeks ending with the week containing end
+func WindowStart(end time.Time, weeks int) time.Time {
+	day := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+	weekdayIdx := (int(day.Weekday()) + 6) % 7 // Monday=0 ... Sunday=6
+	return day.AddDate(0, 0, -weekdayIdx-7*(weeks-1))
+}
+
+// inWindow reports whether t falls within [start, end]
+func inWindow(t, start, end time.Time) bool {
+	return !t.Before(start) && !t.After(end)
+}
+
+// FilterEntriesBetween returns entries with timestamps within [start, end]
+func FilterEntriesBetween(entries []models.Entry, start, end time.Time) []models.Entry {
+	filtered := []models.Entry{}
+	for _, entry := range entries {
+		if inWindow(entry.Timestamp, start, end) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// FilterTodosBetween returns todos created within [start, end]
+func FilterTodosBetween(todos []models.Todo, start, end time.Time) []models.Todo {
+	filtered := []models.Todo{}
+	for _, todo := range todos {
+		if inWindow(todo.CreatedAt, start, end) {
+			filtered = append(filtered, todo)
+		}
+	}
+	return filtered
+}
+
+// TodoWeekStats holds todo flow statistics for a single ISO week
+type TodoWeekStats struct {
+	Year           int
+	Week           int
+	WeekLabel      string  // Format: "W43"
+	Created        int     // Todos created this week
+	Completed      int     // Todos completed this week (by CompletedAt)
+	OpenAtEnd      int     // Backlog: todos created by the end of the week and not yet done then
+	CompletionRate float64 // Share of this week's created todos that are done now (0..1)
+	Pomodoros      int     // Focus mode work intervals completed this week (any todo)
+}
+
+// completedBy reports whether todo was done at time t
+// Done todos without CompletedAt (recorded before completion tracking) count as done from creation
+func completedBy(todo models.Todo, t time.Time) bool {
+	if todo.Status != "done" {
+		return false
+	}
+	if todo.CompletedAt == nil {
+		return !todo.CreatedAt.After(t)
+	}
+	return !todo.CompletedAt.After(t)
+}
+
+// AggregateTodoWeeks computes created/completed counts, completion rate and open backlog
+// for each of the last N ISO weeks ending with the week containing end (oldest first)
+func AggregateTodoWeeks(todos []models.Todo, end time.Time, weeks int) []TodoWeekStats {
+	start := WindowStart(end, weeks)
+	result := make([]TodoWeekStats, weeks)
+
+	for w := 0; w < weeks; w++ {
+		weekStart := start.AddDate(0, 0, 7*w)
+		weekEnd := weekStart.AddDate(0, 0, 7).Add(-time.Nanosecond)
+		year, week := GetISOWeek(weekStart)
+
+		stats := TodoWeekStats{
+			Year:      year,
+			Week:      week,
+			WeekLabel: weekLabel(week),
+		}
+
+		doneOfCreated := 0
+		for _, todo := range todos {
+			if inWindow(todo.CreatedAt, weekStart, weekEnd) {
+				stats.Created++
+				if todo.Status == "done" {
+					doneOfCreated++
+				}
+			}
+			if todo.Status == "done" && todo.CompletedAt != nil && inWindow(*todo.CompletedAt, weekStart, weekEnd) {
+				stats.Completed++
+			}
+			if !todo.CreatedAt.After(weekEnd) && !completedBy(todo, weekEnd) {
+				stats.OpenAtEnd++
+			}
+		}
+
+		stats.Pomodoros = CountPomodorosBetween(todos, weekStart, weekEnd.Add(time.Nanosecond))
+
+		if stats.Created > 0 {
+			stats.CompletionRate = float64(doneOfCreated) / float64(stats.Created)
+		}
+
+		result[w] = stats
+	}
+
+	return result
+}
+
+// TagBreakdown counts entries and todos per tag (exact tags, no rollup)
+// Returns tags sorted by total count (highest first), then alphabetically
+func TagBreakdown(entries []models.Entry, todos []models.Todo) []TagStats {
+	result := CountTagUsage(entries, todos)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		ti := result[i].EntryCount + result[i].TodoCount
+		tj := result[j].EntryCount + result[j].TodoCount
+		return ti > tj
+	})
+
+	return result
+}
+
+// AverageTodosPerEntry returns the mean number of !todo items per entry (0 if no entries)
+func AverageTodosPerEntry(entries []models.Entry) float64 {
+	if len(entries) == 0 {
+		return 0
+	}
+
+	total := 0
+	for _, entry := range entries {
+		total += len(entry.TodoIDs)
+	}
+
+	return float64(total) / float64(len(entries))
+}
+
+// CountByWeekday counts entries and todos created per weekday (Monday first, ISO 8601)
+func CountByWeekday(entries []models.Entry, todos []models.Todo) [7]int {
+	var counts [7]int
+	for _, entry := range entries {
+		counts[(int(entry.Timestamp.Weekday())+6)%7]++
+	}
+	for _, todo := range todos {
+		counts[(int(todo.CreatedAt.Weekday())+6)%7]++
+	}
+	return counts
+}
+
+// CountByHour counts entries and todos created per hour of day (0-23, local to each timestamp)
+func CountByHour(entries []models.Entry, todos []models.Todo) [24]int {
+	var counts [24]int
+	for _, entry := range entries {
+		counts[entry.Timestamp.Hour()]++
+	}
+	for _, todo := range todos {
+		counts[todo.CreatedAt.Hour()]++
+	}
+	return counts
+}
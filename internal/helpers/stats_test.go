@@ -132,3 +132,169 @@ func TestAggregateByWeek_EmptyData(t *testing.T) {
 			stats[0].EntryCount, stats[0].TodoCount)
 	}
 }
+
+func TestAggregateByTopLevelTag(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Tags: []string{"client/acme", "client/globex"}}, // counts once for @client
+		{ID: "2", Tags: []string{"client"}},
+		{ID: "3", Tags: []string{"work"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", Tags: []string{"client/acme/infra"}},
+		{ID: "2", Tags: []string{"work", "personal"}},
+	}
+
+	stats := AggregateByTopLevelTag(entries, todos)
+
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 top-level tags, got %d", len(stats))
+	}
+
+	// Sorted by total count, then alphabetically
+	if stats[0].Tag != "@client" || stats[0].EntryCount != 2 || stats[0].TodoCount != 1 {
+		t.Errorf("Expected @client 2/1 first, got %s %d/%d", stats[0].Tag, stats[0].EntryCount, stats[0].TodoCount)
+	}
+	if stats[1].Tag != "@work" || stats[1].EntryCount != 1 || stats[1].TodoCount != 1 {
+		t.Errorf("Expected @work 1/1 second, got %s %d/%d", stats[1].Tag, stats[1].EntryCount, stats[1].TodoCount)
+	}
+	if stats[2].Tag != "@personal" || stats[2].EntryCount != 0 || stats[2].TodoCount != 1 {
+		t.Errorf("Expected @personal 0/1 third, got %s %d/%d", stats[2].Tag, stats[2].EntryCount, stats[2].TodoCount)
+	}
+}
+
+func TestWindowStart(t *testing.T) {
+	end := time.Date(2026, 10, 15, 14, 30, 0, 0, time.UTC) // Thursday, week 42
+
+	tests := []struct {
+		weeks int
+		want  time.Time
+	}{
+		{1, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{4, time.Date(2026, 9, 21, 0, 0, 0, 0, time.UTC)},
+		{13, time.Date(2026, 7, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := WindowStart(end, test.weeks)
+		if !got.Equal(test.want) {
+			t.Errorf("WindowStart(%d weeks) = %v; want %v", test.weeks, got, test.want)
+		}
+		if got.Weekday() != time.Monday {
+			t.Errorf("WindowStart(%d weeks) is a %v; want Monday", test.weeks, got.Weekday())
+		}
+	}
+}
+
+func TestAggregateTodoWeeks(t *testing.T) {
+	end := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC) // Week 42
+	lastWeek := end.AddDate(0, 0, -7)                     // Week 41
+	doneThisWeek := end.Add(-time.Hour)
+
+	todos := []models.Todo{
+		// Created last week, completed this week
+		{ID: "1", Status: "done", CreatedAt: lastWeek, CompletedAt: &doneThisWeek},
+		// Created last week, still open (one focus session each week)
+		{ID: "2", Status: "open", CreatedAt: lastWeek, Pomodoros: []time.Time{lastWeek, doneThisWeek}},
+		// Created this week, open
+		{ID: "3", Status: "next", CreatedAt: end},
+		// Legacy done todo (no CompletedAt) created this week
+		{ID: "4", Status: "done", CreatedAt: end},
+	}
+
+	stats := AggregateTodoWeeks(todos, end, 4)
+
+	if len(stats) != 4 {
+		t.Fatalf("Expected 4 weeks, got %d", len(stats))
+	}
+
+	week41 := stats[2]
+	if week41.Week != 41 {
+		t.Fatalf("Expected week 41, got %d", week41.Week)
+	}
+	if week41.Pomodoros != 1 {
+		t.Errorf("Week 41 pomodoros = %d; want 1", week41.Pomodoros)
+	}
+	if week41.Created != 2 || week41.Completed != 0 {
+		t.Errorf("Week 41 created/completed = %d/%d; want 2/0", week41.Created, week41.Completed)
+	}
+	if week41.OpenAtEnd != 2 {
+		t.Errorf("Week 41 backlog = %d; want 2 (todo 1 not done until week 42)", week41.OpenAtEnd)
+	}
+	if week41.CompletionRate != 0.5 {
+		t.Errorf("Week 41 completion rate = %v; want 0.5", week41.CompletionRate)
+	}
+
+	week42 := stats[3]
+	if week42.Created != 2 || week42.Completed != 1 {
+		t.Errorf("Week 42 created/completed = %d/%d; want 2/1", week42.Created, week42.Completed)
+	}
+	if week42.OpenAtEnd != 2 {
+		t.Errorf("Week 42 backlog = %d; want 2 (todos 2 and 3)", week42.OpenAtEnd)
+	}
+
+	// Weeks with no todos have zero rate
+	if stats[0].Created != 0 || stats[0].CompletionRate != 0 || stats[0].OpenAtEnd != 0 {
+		t.Errorf("Expected empty first week, got %+v", stats[0])
+	}
+}
+
+func TestTagBreakdown(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Tags: []string{"work"}},
+		{ID: "2", Tags: []string{"work", "client"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", Tags: []string{"client"}},
+		{ID: "2", Tags: []string{"personal"}},
+		{ID: "3", Tags: []string{"client"}},
+	}
+
+	stats := TagBreakdown(entries, todos)
+
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 tags, got %d", len(stats))
+	}
+	if stats[0].Tag != "@client" || stats[0].EntryCount != 1 || stats[0].TodoCount != 2 {
+		t.Errorf("Expected @client 1/2 first, got %+v", stats[0])
+	}
+	if stats[1].Tag != "@work" || stats[2].Tag != "@personal" {
+		t.Errorf("Expected @work then @personal, got %s, %s", stats[1].Tag, stats[2].Tag)
+	}
+}
+
+func TestAverageTodosPerEntry(t *testing.T) {
+	if got := AverageTodosPerEntry(nil); got != 0 {
+		t.Errorf("AverageTodosPerEntry(nil) = %v; want 0", got)
+	}
+
+	entries := []models.Entry{
+		{ID: "1", TodoIDs: []string{"a", "b", "c"}},
+		{ID: "2"},
+	}
+	if got := AverageTodosPerEntry(entries); got != 1.5 {
+		t.Errorf("AverageTodosPerEntry() = %v; want 1.5", got)
+	}
+}
+
+func TestCountByWeekdayAndHour(t *testing.T) {
+	monday9am := time.Date(2026, 10, 12, 9, 15, 0, 0, time.UTC)
+	sunday11pm := time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+
+	entries := []models.Entry{
+		{ID: "1", Timestamp: monday9am},
+		{ID: "2", Timestamp: sunday11pm},
+	}
+	todos := []models.Todo{
+		{ID: "1", CreatedAt: monday9am},
+	}
+
+	weekdays := CountByWeekday(entries, todos)
+	if weekdays[0] != 2 || weekdays[6] != 1 {
+		t.Errorf("CountByWeekday() = %v; want Monday=2, Sunday=1", weekdays)
+	}
+
+	hours := CountByHour(entries, todos)
+	if hours[9] != 2 || hours[23] != 1 {
+		t.Errorf("CountByHour() = %v; want 09=2, 23=1", hours)
+	}
+}
@@ -0,0 +1,191 @@
+package helpers
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// tagNamePattern validates a bare tag name (no @ prefix), optionally hierarchical
+var tagNamePattern = regexp.MustCompile(`^[a-z0-9_-]+(/[a-z0-9_-]+)*$`)
+
+// NormalizeTagName cleans user input into a bare lowercase tag name
+// Accepts "@Dev", "dev" or " @client/Acme " and reports whether the result is valid
+func NormalizeTagName(input string) (string, bool) {
+	name := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(input), "@"))
+	return name, tagNamePattern.MatchString(name)
+}
+
+// RenameTag maps tag to its renamed form if it equals oldTag or is a descendant of it
+// RenameTag("dev/api", "dev", "development") returns "development/api"
+// Tags that don't match are returned unchanged (lowercased)
+func RenameTag(tag, oldTag, newTag string) string {
+	tag = strings.ToLower(tag)
+	oldTag = strings.ToLower(strings.TrimPrefix(oldTag, "@"))
+	newTag = strings.ToLower(strings.TrimPrefix(newTag, "@"))
+
+	if !TagMatches(tag, oldTag) {
+		return tag
+	}
+	return newTag + strings.TrimPrefix(tag, oldTag)
+}
+
+// RenameTagInText rewrites @mentions of oldTag (and its descendants) to newTag
+// Other mentions are left exactly as written
+func RenameTagInText(text, oldTag, newTag string) string {
+	return tagPattern.ReplaceAllStringFunc(text, func(mention string) string {
+		tag := strings.TrimPrefix(mention, "@")
+		if !TagMatches(tag, oldTag) {
+			return mention
+		}
+		return "@" + RenameTag(tag, oldTag, newTag)
+	})
+}
+
+// renameTagList renames matching tags and removes duplicates created by a merge
+func renameTagList(tags []string, oldTag, newTag string) ([]string, bool) {
+	changed := false
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		renamed := RenameTag(tag, oldTag, newTag)
+		if renamed != tag {
+			changed = true
+		}
+		if seen[renamed] {
+			changed = true
+			continue
+		}
+		seen[renamed] = true
+		result = append(result, renamed)
+	}
+
+	return result, changed
+}
+
+// RenameTagInEntries renames (or merges) oldTag into newTag across entries
+// Rewrites both the Tags arrays and the @mentions in titles and bodies
+// Returns the updated entries and how many entries changed
+func RenameTagInEntries(entries []models.Entry, oldTag, newTag string) ([]models.Entry, int) {
+	updated := make([]models.Entry, len(entries))
+	changedCount := 0
+
+	for i, entry := range entries {
+		tags, tagsChanged := renameTagList(entry.Tags, oldTag, newTag)
+		title := RenameTagInText(entry.Title, oldTag, newTag)
+		body := RenameTagInText(entry.Body, oldTag, newTag)
+
+		if tagsChanged || title != entry.Title || body != entry.Body {
+			changedCount++
+		}
+
+		entry.Tags = tags
+		entry.Title = title
+		entry.Body = body
+		entry.Private = IsPrivateTagged(tags) // Renaming @private (or merging into it) changes privacy
+		updated[i] = entry
+	}
+
+	return updated, changedCount
+}
+
+// RenameTagInTodos renames (or merges) oldTag into newTag across todos
+// Rewrites both the Tags arrays and the @mentions in titles
+// Returns the updated todos and how many todos changed
+func RenameTagInTodos(todos []models.Todo, oldTag, newTag string) ([]models.Todo, int) {
+	updated := make([]models.Todo, len(todos))
+	changedCount := 0
+
+	for i, todo := range todos {
+		tags, tagsChanged := renameTagList(todo.Tags, oldTag, newTag)
+		title := RenameTagInText(todo.Title, oldTag, newTag)
+
+		if tagsChanged || title != todo.Title {
+			changedCount++
+		}
+
+		todo.Tags = tags
+		todo.Title = title
+		updated[i] = todo
+	}
+
+	return updated, changedCount
+}
+
+// ApplyTagAliases normalizes extracted tags through the alias table (alias -> canonical)
+// Aliases apply to descendants too: with "dev" -> "development", "dev/api" becomes "development/api"
+func ApplyTagAliases(tags []string, aliases map[string]string) []string {
+	if len(aliases) == 0 {
+		return tags
+	}
+
+	// Longest alias first so the most specific alias wins
+	keys := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		keys = append(keys, alias)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		normalized := strings.ToLower(tag)
+		for _, alias := range keys {
+			if TagMatches(normalized, alias) {
+				normalized = RenameTag(normalized, alias, aliases[alias])
+				break
+			}
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			result = append(result, normalized)
+		}
+	}
+
+	return result
+}
+
+// CountTagUsage counts how many entries and todos use each tag (exact match, no rollup)
+// Returns tags sorted alphabetically with @ prefix
+func CountTagUsage(entries []models.Entry, todos []models.Todo) []TagStats {
+	statsMap := make(map[string]*TagStats)
+
+	get := func(tag string) *TagStats {
+		tag = strings.ToLower(tag)
+		if statsMap[tag] == nil {
+			statsMap[tag] = &TagStats{Tag: "@" + tag}
+		}
+		return statsMap[tag]
+	}
+
+	for _, entry := range entries {
+		for _, tag := range entry.Tags {
+			get(tag).EntryCount++
+		}
+	}
+
+	for _, todo := range todos {
+		for _, tag := range todo.Tags {
+			get(tag).TodoCount++
+		}
+	}
+
+	result := make([]TagStats, 0, len(statsMap))
+	for _, stats := range statsMap {
+		result = append(result, *stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Tag < result[j].Tag
+	})
+
+	return result
+}
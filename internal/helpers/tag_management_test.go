@@ -0,0 +1,198 @@
+package helpers
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestNormalizeTagName(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOK bool
+	}{
+		{"@Dev", "dev", true},
+		{"development", "development", true},
+		{" @client/Acme ", "client/acme", true},
+		{"", "", false},
+		{"two words", "two words", false},
+		{"client/", "client/", false},
+		{"@@dev", "@dev", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeTagName(tt.input)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("NormalizeTagName(%q) = %q, %v; want %q, %v", tt.input, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		tag, oldTag, newTag string
+		want                string
+	}{
+		{"dev", "dev", "development", "development"},
+		{"dev/api", "dev", "development", "development/api"},
+		{"Dev", "@dev", "@development", "development"},
+		{"devops", "dev", "development", "devops"},
+		{"client/acme", "client/acme", "customer/acme", "customer/acme"},
+	}
+
+	for _, tt := range tests {
+		got := RenameTag(tt.tag, tt.oldTag, tt.newTag)
+		if got != tt.want {
+			t.Errorf("RenameTag(%q, %q, %q) = %q, want %q", tt.tag, tt.oldTag, tt.newTag, got, tt.want)
+		}
+	}
+}
+
+func TestRenameTagInText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "simple mention",
+			text: "Worked on @dev today",
+			want: "Worked on @development today",
+		},
+		{
+			name: "mixed case mention",
+			text: "@Dev and @DEV",
+			want: "@development and @development",
+		},
+		{
+			name: "descendant mention",
+			text: "See @dev/api notes",
+			want: "See @development/api notes",
+		},
+		{
+			name: "longer tag with same prefix untouched",
+			text: "@devops is separate",
+			want: "@devops is separate",
+		},
+		{
+			name: "punctuation after mention",
+			text: "Pairing (@dev), done.",
+			want: "Pairing (@development), done.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenameTagInText(tt.text, "dev", "development")
+			if got != tt.want {
+				t.Errorf("RenameTagInText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenameTagInEntries(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Title: "Sync @dev", Body: "Notes @development", Tags: []string{"dev", "development"}},
+		{ID: "2", Title: "Other", Body: "@personal", Tags: []string{"personal"}},
+	}
+
+	updated, changed := RenameTagInEntries(entries, "dev", "development")
+
+	if changed != 1 {
+		t.Errorf("RenameTagInEntries() changed %d entries, want 1", changed)
+	}
+	if updated[0].Title != "Sync @development" {
+		t.Errorf("Title = %q, want %q", updated[0].Title, "Sync @development")
+	}
+	// Merge removes the duplicate tag
+	if !reflect.DeepEqual(updated[0].Tags, []string{"development"}) {
+		t.Errorf("Tags = %v, want [development]", updated[0].Tags)
+	}
+	if !reflect.DeepEqual(updated[1], entries[1]) {
+		t.Errorf("Unrelated entry changed: %v", updated[1])
+	}
+	// Original slice untouched
+	if entries[0].Title != "Sync @dev" {
+		t.Errorf("RenameTagInEntries() mutated input: %q", entries[0].Title)
+	}
+}
+
+func TestRenameTagInTodos(t *testing.T) {
+	todos := []models.Todo{
+		{ID: "1", Title: "Fix build @Dev", Tags: []string{"dev"}},
+		{ID: "2", Title: "Groceries @personal", Tags: []string{"personal"}},
+	}
+
+	updated, changed := RenameTagInTodos(todos, "dev", "development")
+
+	if changed != 1 {
+		t.Errorf("RenameTagInTodos() changed %d todos, want 1", changed)
+	}
+	if updated[0].Title != "Fix build @development" {
+		t.Errorf("Title = %q, want %q", updated[0].Title, "Fix build @development")
+	}
+	if !reflect.DeepEqual(updated[0].Tags, []string{"development"}) {
+		t.Errorf("Tags = %v, want [development]", updated[0].Tags)
+	}
+}
+
+func TestApplyTagAliases(t *testing.T) {
+	aliases := map[string]string{
+		"dev":        "development",
+		"dev/legacy": "archive",
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"no aliases hit", []string{"work"}, []string{"work"}},
+		{"exact alias", []string{"dev"}, []string{"development"}},
+		{"descendant of alias", []string{"dev/api"}, []string{"development/api"}},
+		{"most specific alias wins", []string{"dev/legacy"}, []string{"archive"}},
+		{"dedupe after aliasing", []string{"dev", "development"}, []string{"development"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyTagAliases(tt.tags, aliases)
+			sort.Strings(got)
+			sort.Strings(tt.want)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyTagAliases(%v) = %v, want %v", tt.tags, got, tt.want)
+			}
+		})
+	}
+
+	// Nil alias table returns tags unchanged
+	if got := ApplyTagAliases([]string{"dev"}, nil); !reflect.DeepEqual(got, []string{"dev"}) {
+		t.Errorf("ApplyTagAliases(nil) = %v, want [dev]", got)
+	}
+}
+
+func TestCountTagUsage(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Tags: []string{"work", "client/acme"}},
+		{ID: "2", Tags: []string{"work"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", Tags: []string{"work"}},
+		{ID: "2", Tags: []string{"personal"}},
+	}
+
+	got := CountTagUsage(entries, todos)
+	want := []TagStats{
+		{Tag: "@client/acme", EntryCount: 1, TodoCount: 0},
+		{Tag: "@personal", EntryCount: 0, TodoCount: 1},
+		{Tag: "@work", EntryCount: 2, TodoCount: 1},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountTagUsage() = %v, want %v", got, want)
+	}
+}
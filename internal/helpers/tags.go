@@ -8,6 +8,9 @@ import (
 	"github.com/apodacaa/amos/internal/models"
 )
 
+// tagPattern matches @word patterns (letters, numbers, underscores, hyphens), optionally nested with /
+var tagPattern = regexp.MustCompile(`@([a-zA-Z0-9_-]+(?:/[a-zA-Z0-9_-]+)*)`)
+
 // ParseEntryContent splits entry content into title and body
 // First line becomes title, rest becomes body
 func ParseEntryContent(content string) (title, body string) {
@@ -24,10 +27,9 @@ func ParseEntryContent(content string) (title, body string) {
 }
 
 // ExtractTags finds all @word patterns in text and returns them lowercase
+// Hierarchical tags use "/" separators (e.g., @client/acme, @project/alpha/infra)
 func ExtractTags(text string) []string {
-	// Match @word patterns (letters, numbers, underscores, hyphens)
-	re := regexp.MustCompile(`@([a-zA-Z0-9_-]+)`)
-	matches := re.FindAllStringSubmatch(text, -1)
+	matches := tagPattern.FindAllStringSubmatch(text, -1)
 
 	// Use map to deduplicate
 	tagMap := make(map[string]bool)
@@ -124,6 +126,95 @@ func ExtractUniqueTagsFromAll(entries []models.Entry, todos []models.Todo) []str
 	return tags
 }
 
+// TagMatches reports whether tag equals filter or is a descendant of it (case-insensitive)
+// Filtering on "client" matches "client", "client/acme" and "client/acme/infra"
+func TagMatches(tag, filter string) bool {
+	tag = strings.ToLower(strings.TrimPrefix(tag, "@"))
+	filter = strings.ToLower(strings.TrimPrefix(filter, "@"))
+	if filter == "" {
+		return false
+	}
+	return tag == filter || strings.HasPrefix(tag, filter+"/")
+}
+
+// TopLevelTag returns the root segment of a hierarchical tag ("client/acme" -> "client")
+func TopLevelTag(tag string) string {
+	if idx := strings.Index(tag, "/"); idx >= 0 {
+		return tag[:idx]
+	}
+	return tag
+}
+
+// TagNode is a single node in the hierarchical tag tree
+type TagNode struct {
+	Tag   string // Full tag path with @ prefix (e.g., "@client/acme")
+	Name  string // Last path segment (e.g., "acme")
+	Depth int    // 0 for top-level tags
+	Count int    // Entries + todos tagged with this tag or any descendant
+}
+
+// BuildTagTree collects all tags from entries and todos as a depth-first tree
+// Parent tags are included even when only descendants are used (@project for @project/alpha)
+// Counts roll up: an item is counted once per ancestor, however many descendants it carries
+func BuildTagTree(entries []models.Entry, todos []models.Todo) []TagNode {
+	counts := make(map[string]int) // tag path -> item count
+
+	countItem := func(itemTags []string) {
+		seen := make(map[string]bool)
+		for _, tag := range itemTags {
+			segments := strings.Split(strings.ToLower(tag), "/")
+			for i := range segments {
+				path := strings.Join(segments[:i+1], "/")
+				if !seen[path] {
+					seen[path] = true
+					counts[path]++
+				}
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		countItem(entry.Tags)
+	}
+	for _, todo := range todos {
+		countItem(todo.Tags)
+	}
+
+	// Segment-wise sort yields depth-first order (parents directly before their children)
+	paths := make([]string, 0, len(counts))
+	for path := range counts {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return lessTagPath(paths[i], paths[j])
+	})
+
+	nodes := make([]TagNode, 0, len(paths))
+	for _, path := range paths {
+		segments := strings.Split(path, "/")
+		nodes = append(nodes, TagNode{
+			Tag:   "@" + path,
+			Name:  segments[len(segments)-1],
+			Depth: len(segments) - 1,
+			Count: counts[path],
+		})
+	}
+
+	return nodes
+}
+
+// lessTagPath compares tag paths segment by segment so parents precede their children
+func lessTagPath(a, b string) bool {
+	as := strings.Split(a, "/")
+	bs := strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] != bs[i] {
+			return as[i] < bs[i]
+		}
+	}
+	return len(as) < len(bs)
+}
+
 // FilterEntriesByTag filters entries to only those containing the specified tag
 // Tag should be provided with @ prefix (e.g., "@client")
 // Returns filtered list or original list if filterTag is empty
@@ -137,7 +228,7 @@ func FilterEntriesByTag(entries []models.Entry, filterTag string) []models.Entry
 
 	for _, entry := range entries {
 		for _, entryTag := range entry.Tags {
-			if entryTag == tagWithoutAt {
+			if TagMatches(entryTag, tagWithoutAt) {
 				filtered = append(filtered, entry)
 				break
 			}
@@ -169,7 +260,7 @@ func FilterEntriesByTags(entries []models.Entry, filterTags []string) []models.E
 		for _, filterTag := range normalizedFilters {
 			found := false
 			for _, entryTag := range entry.Tags {
-				if strings.ToLower(entryTag) == filterTag {
+				if TagMatches(entryTag, filterTag) {
 					found = true
 					break
 				}
@@ -210,7 +301,7 @@ func FilterTodosByTags(todos []models.Todo, filterTags []string) []models.Todo {
 		for _, filterTag := range normalizedFilters {
 			found := false
 			for _, todoTag := range todo.Tags {
-				if strings.ToLower(todoTag) == filterTag {
+				if TagMatches(todoTag, filterTag) {
 					found = true
 					break
 				}
@@ -85,6 +85,16 @@ func TestExtractTags(t *testing.T) {
 			text: "@q1-2024 @sprint23",
 			want: []string{"q1-2024", "sprint23"},
 		},
+		{
+			name: "hierarchical tags",
+			text: "Call with @client/acme about @project/alpha/infra",
+			want: []string{"client/acme", "project/alpha/infra"},
+		},
+		{
+			name: "trailing slash not part of tag",
+			text: "Notes for @client/ later",
+			want: []string{"client"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -332,3 +342,108 @@ func TestFilterEntriesByTag(t *testing.T) {
 		})
 	}
 }
+
+func TestTagMatches(t *testing.T) {
+	tests := []struct {
+		tag    string
+		filter string
+		want   bool
+	}{
+		{"client", "client", true},
+		{"client/acme", "client", true},
+		{"client/acme/infra", "@client", true},
+		{"client/acme", "client/acme", true},
+		{"Client/Acme", "client", true},
+		{"client", "client/acme", false},
+		{"clients", "client", false},
+		{"client-acme", "client", false},
+		{"client", "", false},
+	}
+
+	for _, tt := range tests {
+		got := TagMatches(tt.tag, tt.filter)
+		if got != tt.want {
+			t.Errorf("TagMatches(%q, %q) = %v, want %v", tt.tag, tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestFilterByHierarchicalTags(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Title: "Acme", Tags: []string{"client/acme"}},
+		{ID: "2", Title: "Globex", Tags: []string{"client/globex", "urgent"}},
+		{ID: "3", Title: "Client", Tags: []string{"client"}},
+		{ID: "4", Title: "Other", Tags: []string{"clients"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", Title: "Acme infra", Tags: []string{"client/acme/infra"}},
+		{ID: "2", Title: "Personal", Tags: []string{"personal"}},
+	}
+
+	got := FilterEntriesByTags(entries, []string{"@client"})
+	if len(got) != 3 {
+		t.Fatalf("FilterEntriesByTags(@client) returned %d entries, want 3", len(got))
+	}
+
+	got = FilterEntriesByTags(entries, []string{"@client", "@urgent"})
+	if len(got) != 1 || got[0].Title != "Globex" {
+		t.Errorf("FilterEntriesByTags(@client @urgent) = %v, want [Globex]", got)
+	}
+
+	got = FilterEntriesByTag(entries, "@client/acme")
+	if len(got) != 1 || got[0].Title != "Acme" {
+		t.Errorf("FilterEntriesByTag(@client/acme) = %v, want [Acme]", got)
+	}
+
+	gotTodos := FilterTodosByTags(todos, []string{"@client/acme"})
+	if len(gotTodos) != 1 || gotTodos[0].Title != "Acme infra" {
+		t.Errorf("FilterTodosByTags(@client/acme) = %v, want [Acme infra]", gotTodos)
+	}
+}
+
+func TestBuildTagTree(t *testing.T) {
+	entries := []models.Entry{
+		{ID: "1", Tags: []string{"client/acme", "client/globex"}},
+		{ID: "2", Tags: []string{"client/acme"}},
+		{ID: "3", Tags: []string{"work"}},
+	}
+	todos := []models.Todo{
+		{ID: "1", Tags: []string{"project/alpha/infra"}},
+		{ID: "2", Tags: []string{"client"}},
+	}
+
+	got := BuildTagTree(entries, todos)
+
+	want := []TagNode{
+		{Tag: "@client", Name: "client", Depth: 0, Count: 3},
+		{Tag: "@client/acme", Name: "acme", Depth: 1, Count: 2},
+		{Tag: "@client/globex", Name: "globex", Depth: 1, Count: 1},
+		{Tag: "@project", Name: "project", Depth: 0, Count: 1},
+		{Tag: "@project/alpha", Name: "alpha", Depth: 1, Count: 1},
+		{Tag: "@project/alpha/infra", Name: "infra", Depth: 2, Count: 1},
+		{Tag: "@work", Name: "work", Depth: 0, Count: 1},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildTagTree() =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestBuildTagTreeParentBeforeSiblingPrefix(t *testing.T) {
+	// "client-old" sorts between "client" and "client/acme" as a plain string;
+	// the tree must still keep children directly under their parent
+	entries := []models.Entry{
+		{ID: "1", Tags: []string{"client/acme", "client-old"}},
+	}
+
+	got := BuildTagTree(entries, nil)
+
+	var order []string
+	for _, node := range got {
+		order = append(order, node.Tag)
+	}
+	want := []string{"@client", "@client/acme", "@client-old"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("BuildTagTree() order = %v, want %v", order, want)
+	}
+}
@@ -0,0 +1,203 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// TimesheetUntagged is the timesheet row for time tracked on todos without tags
+const TimesheetUntagged = "(untagged)"
+
+// TimerRunning reports whether todo has an open interval
+func TimerRunning(todo models.Todo) bool {
+	n := len(todo.TimeLog)
+	return n > 0 && todo.TimeLog[n-1].End == nil
+}
+
+// RunningTimer returns the todo whose timer is running (only one runs at a time)
+func RunningTimer(todos []models.Todo) (models.Todo, bool) {
+	for _, todo := range todos {
+		if TimerRunning(todo) {
+			return todo, true
+		}
+	}
+	return models.Todo{}, false
+}
+
+// StartTimer opens a new interval on todo (unchanged if its timer already runs)
+func StartTimer(todo models.Todo, now time.Time) models.Todo {
+	if TimerRunning(todo) {
+		return todo
+	}
+	todo.TimeLog = append(append([]models.TimeInterval{}, todo.TimeLog...), models.TimeInterval{Start: now})
+	return todo
+}
+
+// StopTimer closes the open interval on todo (unchanged if no timer runs)
+func StopTimer(todo models.Todo, now time.Time) models.Todo {
+	if !TimerRunning(todo) {
+		return todo
+	}
+	timeLog := append([]models.TimeInterval{}, todo.TimeLog...)
+	last := &timeLog[len(timeLog)-1]
+	end := now
+	if end.Before(last.Start) {
+		end = last.Start // Clock went backwards: record an empty interval
+	}
+	last.End = &end
+	todo.TimeLog = timeLog
+	return todo
+}
+
+// TrackedTime sums the intervals of todo (a running interval counts up to now)
+func TrackedTime(todo models.Todo, now time.Time) time.Duration {
+	var total time.Duration
+	for _, interval := range todo.TimeLog {
+		end := now
+		if interval.End != nil {
+			end = *interval.End
+		}
+		if end.After(interval.Start) {
+			total += end.Sub(interval.Start)
+		}
+	}
+	return total
+}
+
+// RunningFor returns how long the running timer on todo has been going (0 if none runs)
+func RunningFor(todo models.Todo, now time.Time) time.Duration {
+	if !TimerRunning(todo) {
+		return 0
+	}
+	if start := todo.TimeLog[len(todo.TimeLog)-1].Start; now.After(start) {
+		return now.Sub(start)
+	}
+	return 0
+}
+
+// FormatDuration formats d as hours and minutes, e.g. "2:05" (seconds are dropped)
+func FormatDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+// FormatTimerClock formats d with seconds for a running timer, e.g. "0:42:07"
+func FormatTimerClock(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// TimesheetRow is the tracked time for one tag in an ISO week (Monday first)
+type TimesheetRow struct {
+	Tag   string
+	Days  [7]time.Duration
+	Total time.Duration
+}
+
+// Timesheet sums tracked time by tag and weekday for one ISO week
+// A todo with several tags counts in each of their rows; Days and Total count it once
+type Timesheet struct {
+	Year  int
+	Week  int
+	Rows  []TimesheetRow // Most time first
+	Days  [7]time.Duration
+	Total time.Duration
+}
+
+// BuildTimesheet sums the intervals of todos that fall in the ISO week (running ones up to now)
+// Intervals crossing midnight are split between the days in loc
+func BuildTimesheet(todos []models.Todo, year, week int, loc *time.Location, now time.Time) Timesheet {
+	sheet := Timesheet{Year: year, Week: week}
+	weekStart := ISOWeekStart(year, week, loc)
+	rows := make(map[string]*TimesheetRow)
+
+	for _, todo := range todos {
+		var days [7]time.Duration
+		tracked := false
+		for _, interval := range todo.TimeLog {
+			end := now
+			if interval.End != nil {
+				end = *interval.End
+			}
+			for day := 0; day < 7; day++ {
+				dayStart := weekStart.AddDate(0, 0, day)
+				dayEnd := weekStart.AddDate(0, 0, day+1)
+				from, to := interval.Start, end
+				if from.Before(dayStart) {
+					from = dayStart
+				}
+				if to.After(dayEnd) {
+					to = dayEnd
+				}
+				if to.After(from) {
+					days[day] += to.Sub(from)
+					tracked = true
+				}
+			}
+		}
+		if !tracked {
+			continue
+		}
+
+		tags := todo.Tags
+		if len(tags) == 0 {
+			tags = []string{TimesheetUntagged}
+		}
+		for _, tag := range tags {
+			row := rows[tag]
+			if row == nil {
+				row = &TimesheetRow{Tag: tag}
+				rows[tag] = row
+			}
+			for day, d := range days {
+				row.Days[day] += d
+				row.Total += d
+			}
+		}
+		for day, d := range days {
+			sheet.Days[day] += d
+			sheet.Total += d
+		}
+	}
+
+	for _, row := range rows {
+		sheet.Rows = append(sheet.Rows, *row)
+	}
+	sort.Slice(sheet.Rows, func(i, j int) bool {
+		if sheet.Rows[i].Total != sheet.Rows[j].Total {
+			return sheet.Rows[i].Total > sheet.Rows[j].Total
+		}
+		return sheet.Rows[i].Tag < sheet.Rows[j].Tag
+	})
+	return sheet
+}
+
+// FormatTimesheetCSV exports a timesheet as CSV in decimal hours (one row per tag, then the total)
+func FormatTimesheetCSV(sheet Timesheet) string {
+	hours := func(d time.Duration) string {
+		return fmt.Sprintf("%.2f", d.Hours())
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"tag", "mon", "tue", "wed", "thu", "fri", "sat", "sun", "total"})
+	for _, row := range sheet.Rows {
+		record := []string{row.Tag}
+		for _, d := range row.Days {
+			record = append(record, hours(d))
+		}
+		w.Write(append(record, hours(row.Total)))
+	}
+	total := []string{"total"}
+	for _, d := range sheet.Days {
+		total = append(total, hours(d))
+	}
+	w.Write(append(total, hours(sheet.Total)))
+	w.Flush()
+	return buf.String()
+}
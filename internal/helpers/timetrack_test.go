@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestStartStopTimer(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	todo := models.Todo{ID: "1"}
+
+	todo = StartTimer(todo, start)
+	if !TimerRunning(todo) {
+		t.Fatal("Expected timer running after start")
+	}
+	if again := StartTimer(todo, start.Add(time.Minute)); len(again.TimeLog) != 1 {
+		t.Error("Expected starting a running timer to be a no-op")
+	}
+	if got := TrackedTime(todo, start.Add(10*time.Minute)); got != 10*time.Minute {
+		t.Errorf("Expected running interval to count up to now, got %v", got)
+	}
+	if got := RunningFor(todo, start.Add(10*time.Minute)); got != 10*time.Minute {
+		t.Errorf("RunningFor() = %v, want 10m", got)
+	}
+
+	running := todo
+	todo = StopTimer(todo, start.Add(90*time.Minute))
+	if TimerRunning(todo) || !TimerRunning(running) {
+		t.Error("Expected stop to close the interval without touching the original")
+	}
+	if got := TrackedTime(todo, start.Add(5*time.Hour)); got != 90*time.Minute {
+		t.Errorf("TrackedTime() = %v, want 1h30m", got)
+	}
+
+	todos := []models.Todo{{ID: "a"}, running, todo}
+	if got, ok := RunningTimer(todos); !ok || got.ID != "1" || !TimerRunning(got) {
+		t.Errorf("RunningTimer() = %v, %v", got.ID, ok)
+	}
+	if _, ok := RunningTimer([]models.Todo{todo}); ok {
+		t.Error("Expected no running timer")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	if got := FormatDuration(2*time.Hour + 5*time.Minute + 59*time.Second); got != "2:05" {
+		t.Errorf("FormatDuration() = %q, want 2:05", got)
+	}
+	if got := FormatTimerClock(42*time.Minute + 7*time.Second); got != "0:42:07" {
+		t.Errorf("FormatTimerClock() = %q, want 0:42:07", got)
+	}
+}
+
+func TestBuildTimesheet(t *testing.T) {
+	// ISO week 2026-W43 starts Monday 2026-10-19
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+	end := func(day, hour, minute int) *time.Time {
+		ts := at(day, hour, minute)
+		return &ts
+	}
+
+	todos := []models.Todo{
+		// 2h Monday for two tags
+		{ID: "1", Tags: []string{"client/acme", "dev"}, TimeLog: []models.TimeInterval{{Start: at(19, 9, 0), End: end(19, 11, 0)}}},
+		// Crosses midnight Tuesday -> Wednesday: 1h each
+		{ID: "2", Tags: []string{"client/acme"}, TimeLog: []models.TimeInterval{{Start: at(20, 23, 0), End: end(21, 1, 0)}}},
+		// Untagged, still running since Thursday 10:00
+		{ID: "3", TimeLog: []models.TimeInterval{{Start: at(22, 10, 0)}}},
+		// Previous week only
+		{ID: "4", Tags: []string{"dev"}, TimeLog: []models.TimeInterval{{Start: at(12, 9, 0), End: end(12, 17, 0)}}},
+	}
+
+	sheet := BuildTimesheet(todos, 2026, 43, time.UTC, at(22, 10, 30))
+
+	if sheet.Total != 4*time.Hour+30*time.Minute {
+		t.Errorf("Total = %v, want 4h30m (multi-tag todo counted once)", sheet.Total)
+	}
+	if sheet.Days[0] != 2*time.Hour || sheet.Days[1] != time.Hour || sheet.Days[2] != time.Hour || sheet.Days[3] != 30*time.Minute {
+		t.Errorf("Unexpected day totals %v", sheet.Days)
+	}
+
+	want := []struct {
+		tag   string
+		total time.Duration
+	}{
+		{"client/acme", 4 * time.Hour},
+		{"dev", 2 * time.Hour},
+		{TimesheetUntagged, 30 * time.Minute},
+	}
+	if len(sheet.Rows) != len(want) {
+		t.Fatalf("Expected %d rows, got %+v", len(want), sheet.Rows)
+	}
+	for i, w := range want {
+		if sheet.Rows[i].Tag != w.tag || sheet.Rows[i].Total != w.total {
+			t.Errorf("Row %d = %s %v, want %s %v", i, sheet.Rows[i].Tag, sheet.Rows[i].Total, w.tag, w.total)
+		}
+	}
+
+	csv := FormatTimesheetCSV(sheet)
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	if len(lines) != 5 || lines[0] != "tag,mon,tue,wed,thu,fri,sat,sun,total" {
+		t.Fatalf("Unexpected CSV:\n%s", csv)
+	}
+	if lines[1] != "client/acme,2.00,1.00,1.00,0.00,0.00,0.00,0.00,4.00" {
+		t.Errorf("Unexpected tag row %q", lines[1])
+	}
+	if lines[4] != "total,2.00,1.00,1.00,0.50,0.00,0.00,0.00,4.50" {
+		t.Errorf("Unexpected total row %q", lines[4])
+	}
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// RetitleTodo replaces a todo's title and re-extracts its tags and people from it
+// The title stays the source of truth (same extraction as new todos)
+func RetitleTodo(todo models.Todo, title string, aliases map[string]string) models.Todo {
+	todo.Title = strings.TrimSpace(title)
+	todo.Tags = ApplyTagAliases(ExtractTags(todo.Title), aliases)
+	sort.Strings(todo.Tags)
+	todo.People = ExtractPeople(todo.Title)
+	return todo
+}
+
+// ParseTagList parses an edited tag list ("@work home, client/acme") into bare tag names
+// Duplicates are dropped; an empty input means no tags
+func ParseTagList(input string) ([]string, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	tags := []string{}
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		tag, ok := NormalizeTagName(field)
+		if !ok {
+			return nil, fmt.Errorf("invalid tag %q", field)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// SetTitleTags rewrites the @mentions in title so they match tags exactly
+// Mentions of kept tags stay where they are, removed ones are dropped and new ones appended
+func SetTitleTags(title string, tags []string) string {
+	keep := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		keep[tag] = true
+	}
+
+	present := make(map[string]bool)
+	title = tagPattern.ReplaceAllStringFunc(title, func(mention string) string {
+		tag := strings.ToLower(strings.TrimPrefix(mention, "@"))
+		if !keep[tag] {
+			return ""
+		}
+		present[tag] = true
+		return mention
+	})
+
+	words := strings.Fields(title)
+	for _, tag := range tags {
+		if !present[tag] {
+			words = append(words, "@"+tag)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// FormatTagList renders tags for the tag editor ("@work @client/acme")
+func FormatTagList(tags []string) string {
+	mentions := make([]string, len(tags))
+	for i, tag := range tags {
+		mentions[i] = "@" + tag
+	}
+	return strings.Join(mentions, " ")
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestRetitleTodo(t *testing.T) {
+	todo := models.Todo{ID: "1", Title: "Call bob @wrok", Status: "next", Tags: []string{"wrok"}}
+
+	got := RetitleTodo(todo, "  Call +bob @work @js ", map[string]string{"js": "javascript"})
+
+	if got.Title != "Call +bob @work @js" {
+		t.Errorf("Title = %q", got.Title)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"javascript", "work"}) {
+		t.Errorf("Tags = %v, want [javascript work]", got.Tags)
+	}
+	if !reflect.DeepEqual(got.People, []string{"bob"}) {
+		t.Errorf("People = %v, want [bob]", got.People)
+	}
+	if got.ID != "1" || got.Status != "next" {
+		t.Errorf("Expected other fields unchanged, got %+v", got)
+	}
+}
+
+func TestParseTagList(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{input: "@work home, client/Acme", want: []string{"work", "home", "client/acme"}},
+		{input: "@work @work", want: []string{"work"}},
+		{input: "  ", want: []string{}},
+		{input: "@bad!tag", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTagList(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTagList(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseTagList(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSetTitleTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		tags  []string
+		want  string
+	}{
+		{name: "keep in place", title: "Fix @Work login bug", tags: []string{"work"}, want: "Fix @Work login bug"},
+		{name: "remove", title: "Fix @work login @urgent bug", tags: []string{"work"}, want: "Fix @work login bug"},
+		{name: "append", title: "Fix login", tags: []string{"work", "client/acme"}, want: "Fix login @work @client/acme"},
+		{name: "clear", title: "Fix @work login", tags: []string{}, want: "Fix login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetTitleTags(tt.title, tt.tags); got != tt.want {
+				t.Errorf("SetTitleTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
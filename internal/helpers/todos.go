@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/apodacaa/amos/internal/models"
@@ -44,3 +45,77 @@ func CountTodoStats(todos []models.Todo) (open int, total int) {
 	}
 	return open, total
 }
+
+// BoardStatuses are the built-in todo statuses, in board column order
+var BoardStatuses = []string{"open", "next", "done"}
+
+// BoardColumn is one status column on the todo board
+type BoardColumn struct {
+	Status string
+	Todos  []models.Todo
+}
+
+// GroupTodosByStatus splits todos into one column per status for the board
+// Built-in statuses always get a column (even when empty); any other status found
+// gets its own column after them, alphabetically. Todo order within a column is preserved.
+func GroupTodosByStatus(todos []models.Todo) []BoardColumn {
+	byStatus := make(map[string][]models.Todo)
+	var extra []string
+	for _, todo := range todos {
+		if _, seen := byStatus[todo.Status]; !seen && !isBoardStatus(todo.Status) {
+			extra = append(extra, todo.Status)
+		}
+		byStatus[todo.Status] = append(byStatus[todo.Status], todo)
+	}
+	sort.Strings(extra)
+
+	columns := make([]BoardColumn, 0, len(BoardStatuses)+len(extra))
+	for _, status := range append(append([]string{}, BoardStatuses...), extra...) {
+		column := BoardColumn{Status: status, Todos: byStatus[status]}
+		if column.Todos == nil {
+			column.Todos = []models.Todo{}
+		}
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+// BoardColumnIndex returns the position of the column for status (-1 if the board has none)
+func BoardColumnIndex(columns []BoardColumn, status string) int {
+	for i, column := range columns {
+		if column.Status == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// ClampBoardSelection keeps a selection inside the board layout and returns the selected column
+// Columns come and go with custom statuses, so rows of columns that no longer exist are dropped
+func ClampBoardSelection(columns []BoardColumn, selected int, rows map[string]int) int {
+	for status := range rows {
+		if BoardColumnIndex(columns, status) < 0 {
+			delete(rows, status)
+		}
+	}
+	for _, column := range columns {
+		if rows[column.Status] >= len(column.Todos) {
+			rows[column.Status] = max(len(column.Todos)-1, 0)
+		}
+		if rows[column.Status] < 0 {
+			rows[column.Status] = 0
+		}
+	}
+	return min(max(selected, 0), len(columns)-1)
+}
+
+// isBoardStatus reports whether status is one of the built-in BoardStatuses
+func isBoardStatus(status string) bool {
+	for _, s := range BoardStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -169,3 +169,71 @@ func TestCountTodoStats(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupTodosByStatus(t *testing.T) {
+	todos := []models.Todo{
+		{ID: "1", Status: "next"},
+		{ID: "2", Status: "waiting"},
+		{ID: "3", Status: "open"},
+		{ID: "4", Status: "next"},
+		{ID: "5", Status: "blocked"},
+	}
+
+	columns := GroupTodosByStatus(todos)
+
+	wantStatuses := []string{"open", "next", "done", "blocked", "waiting"}
+	if len(columns) != len(wantStatuses) {
+		t.Fatalf("Expected %d columns, got %d", len(wantStatuses), len(columns))
+	}
+	for i, column := range columns {
+		if column.Status != wantStatuses[i] {
+			t.Errorf("Column %d = %s, want %s", i, column.Status, wantStatuses[i])
+		}
+	}
+
+	// Order within a column is preserved
+	if len(columns[1].Todos) != 2 || columns[1].Todos[0].ID != "1" || columns[1].Todos[1].ID != "4" {
+		t.Errorf("Expected next column [1 4], got %v", columns[1].Todos)
+	}
+
+	// Empty built-in column is present but empty (not nil)
+	if columns[2].Todos == nil || len(columns[2].Todos) != 0 {
+		t.Errorf("Expected empty done column, got %v", columns[2].Todos)
+	}
+}
+
+func TestBoardSelectionAfterMovingLastCardOutOfCustomColumn(t *testing.T) {
+	todos := []models.Todo{
+		{ID: "1", Status: "blocked"},
+		{ID: "2", Status: "waiting"},
+	}
+	columns := GroupTodosByStatus(todos) // open next done blocked waiting
+	rows := map[string]int{"blocked": 0, "waiting": 0}
+
+	// L on the only "blocked" card: the column disappears, "waiting" shifts from 4 to 3
+	todos[0].Status = columns[4].Status
+	columns = GroupTodosByStatus(todos)
+	if len(columns) != 4 {
+		t.Fatalf("Expected 4 columns, got %d", len(columns))
+	}
+	selected := BoardColumnIndex(columns, "waiting")
+	if selected != 3 {
+		t.Fatalf("BoardColumnIndex(waiting) = %d, want 3", selected)
+	}
+	rows["waiting"] = 1
+	if got := ClampBoardSelection(columns, selected, rows); got != 3 {
+		t.Errorf("ClampBoardSelection() = %d, want 3", got)
+	}
+	if _, ok := rows["blocked"]; ok || rows["waiting"] != 1 {
+		t.Errorf("Rows = %v", rows)
+	}
+
+	// Out of range selections are pulled back in
+	rows["waiting"] = 9
+	if got := ClampBoardSelection(columns, 4, rows); got != 3 || rows["waiting"] != 1 {
+		t.Errorf("Clamp = %d, rows %v", got, rows)
+	}
+	if BoardColumnIndex(columns, "blocked") != -1 {
+		t.Error("Expected no blocked column")
+	}
+}
@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// DefaultPurgeTrashAfterDays is used when config.json doesn't set purge_trash_after_days
+const DefaultPurgeTrashAfterDays = 30
+
+// PurgeTrashAfterDays returns how many days deleted todos stay in trash.json (0 = never purge)
+func PurgeTrashAfterDays(cfg models.Config) int {
+	if cfg.PurgeTrashAfterDays == nil {
+		return DefaultPurgeTrashAfterDays
+	}
+	if *cfg.PurgeTrashAfterDays < 0 {
+		return 0
+	}
+	return *cfg.PurgeTrashAfterDays
+}
+
+// TrashedTodosToPurge returns the IDs of todos deleted more than days ago
+// Todos without DeletedAt (hand-edited trash.json) are kept
+func TrashedTodosToPurge(todos []models.Todo, days int, now time.Time) map[string]bool {
+	ids := make(map[string]bool)
+	if days <= 0 {
+		return ids
+	}
+
+	cutoff := now.AddDate(0, 0, -days)
+	for _, todo := range todos {
+		if todo.DeletedAt != nil && todo.DeletedAt.Before(cutoff) {
+			ids[todo.ID] = true
+		}
+	}
+	return ids
+}
+
+// SortTrashedTodos returns trashed todos most recently deleted first (stable, input untouched)
+func SortTrashedTodos(todos []models.Todo) []models.Todo {
+	sorted := append([]models.Todo{}, todos...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].DeletedAt, sorted[j].DeletedAt
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.After(*b)
+	})
+	return sorted
+}
+
+// CountMissingTodos counts todoIDs that are not in todos (archived, deleted or purged)
+func CountMissingTodos(todoIDs []string, todos []models.Todo) int {
+	present := make(map[string]bool, len(todos))
+	for _, todo := range todos {
+		present[todo.ID] = true
+	}
+
+	missing := 0
+	for _, id := range todoIDs {
+		if !present[id] {
+			missing++
+		}
+	}
+	return missing
+}
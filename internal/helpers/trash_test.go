@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestPurgeTrashAfterDays(t *testing.T) {
+	days := func(n int) *int { return &n }
+
+	tests := []struct {
+		name string
+		cfg  models.Config
+		want int
+	}{
+		{name: "default", cfg: models.Config{}, want: DefaultPurgeTrashAfterDays},
+		{name: "configured", cfg: models.Config{PurgeTrashAfterDays: days(7)}, want: 7},
+		{name: "disabled", cfg: models.Config{PurgeTrashAfterDays: days(0)}, want: 0},
+		{name: "negative means disabled", cfg: models.Config{PurgeTrashAfterDays: days(-3)}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PurgeTrashAfterDays(tt.cfg); got != tt.want {
+				t.Errorf("PurgeTrashAfterDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrashedTodosToPurge(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) *time.Time {
+		at := now.AddDate(0, 0, -n)
+		return &at
+	}
+
+	todos := []models.Todo{
+		{ID: "old", DeletedAt: daysAgo(45)},
+		{ID: "recent", DeletedAt: daysAgo(2)},
+		{ID: "no-date"},
+	}
+
+	got := TrashedTodosToPurge(todos, 30, now)
+	if len(got) != 1 || !got["old"] {
+		t.Errorf("Expected only old, got %v", got)
+	}
+
+	if got := TrashedTodosToPurge(todos, 0, now); len(got) != 0 {
+		t.Errorf("Expected nothing when disabled, got %v", got)
+	}
+}
+
+func TestSortTrashedTodos(t *testing.T) {
+	at := func(day int) *time.Time {
+		ts := time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)
+		return &ts
+	}
+	todos := []models.Todo{
+		{ID: "1", DeletedAt: at(1)},
+		{ID: "2"},
+		{ID: "3", DeletedAt: at(5)},
+	}
+
+	sorted := SortTrashedTodos(todos)
+
+	want := []string{"3", "1", "2"}
+	for i, id := range want {
+		if sorted[i].ID != id {
+			t.Fatalf("Position %d = %s, want %s (got %v)", i, sorted[i].ID, id, sorted)
+		}
+	}
+}
+
+func TestCountMissingTodos(t *testing.T) {
+	todos := []models.Todo{{ID: "a"}, {ID: "b"}}
+
+	if got := CountMissingTodos([]string{"a", "b", "c", "d"}, todos); got != 2 {
+		t.Errorf("CountMissingTodos() = %d, want 2", got)
+	}
+	if got := CountMissingTodos(nil, todos); got != 0 {
+		t.Errorf("Expected 0 for no linked todos, got %d", got)
+	}
+}
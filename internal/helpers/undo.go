@@ -0,0 +1,189 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// UndoWindow is how long undo history survives (across restarts) before it is dropped
+const UndoWindow = 12 * time.Hour
+
+// MaxUndoRecords caps each of the undo and redo stacks
+const MaxUndoRecords = 100
+
+// PushUndo records a new action: it goes on the undo stack and clears the redo stack
+func PushUndo(log models.UndoLog, record models.UndoRecord) models.UndoLog {
+	undo := append(append([]models.UndoRecord{}, log.Undo...), record)
+	if len(undo) > MaxUndoRecords {
+		undo = undo[len(undo)-MaxUndoRecords:]
+	}
+	return models.UndoLog{Undo: undo, Redo: []models.UndoRecord{}}
+}
+
+// MoveUndoRecord pops the last record from the undo stack onto the redo stack (undo=true)
+// or from the redo stack back onto the undo stack (undo=false)
+func MoveUndoRecord(log models.UndoLog, undo bool) models.UndoLog {
+	from, to := log.Undo, log.Redo
+	if !undo {
+		from, to = log.Redo, log.Undo
+	}
+	if len(from) == 0 {
+		return log
+	}
+
+	record := from[len(from)-1]
+	from = append([]models.UndoRecord{}, from[:len(from)-1]...)
+	to = append(append([]models.UndoRecord{}, to...), record)
+
+	if undo {
+		return models.UndoLog{Undo: from, Redo: to}
+	}
+	return models.UndoLog{Undo: to, Redo: from}
+}
+
+// PruneUndoLog drops records older than UndoWindow (the session window)
+func PruneUndoLog(log models.UndoLog, now time.Time) models.UndoLog {
+	keep := func(records []models.UndoRecord) []models.UndoRecord {
+		kept := []models.UndoRecord{}
+		for _, record := range records {
+			if now.Sub(record.At) <= UndoWindow {
+				kept = append(kept, record)
+			}
+		}
+		return kept
+	}
+	return models.UndoLog{Undo: keep(log.Undo), Redo: keep(log.Redo)}
+}
+
+// TodoUndoRecord builds a record for a single todo change (before nil = created)
+func TodoUndoRecord(label string, before, after *models.Todo, now time.Time) models.UndoRecord {
+	id := ""
+	if after != nil {
+		id = after.ID
+	} else if before != nil {
+		id = before.ID
+	}
+	return models.UndoRecord{
+		Label: label,
+		At:    now,
+		Todos: []models.TodoChange{{ID: id, Before: before, After: after}},
+	}
+}
+
+// upsertTodo replaces the todo with the same ID in place, or appends it
+func upsertTodo(todos []models.Todo, todo models.Todo) []models.Todo {
+	for i := range todos {
+		if todos[i].ID == todo.ID {
+			todos[i] = todo
+			return todos
+		}
+	}
+	return append(todos, todo)
+}
+
+// removeTodo drops the todo with the given ID
+func removeTodo(todos []models.Todo, id string) []models.Todo {
+	kept := make([]models.Todo, 0, len(todos))
+	for _, todo := range todos {
+		if todo.ID != id {
+			kept = append(kept, todo)
+		}
+	}
+	return kept
+}
+
+// ApplyTodoChanges rewinds (undo=true, restores Before) or replays (undo=false, restores After)
+// the changes against todos.json, archive.json and trash.json contents
+// Applying the same record twice gives the same result (snapshots, not deltas)
+func ApplyTodoChanges(todos, archived, trashed []models.Todo, changes []models.TodoChange, undo bool) ([]models.Todo, []models.Todo, []models.Todo) {
+	todos = append([]models.Todo{}, todos...)
+	archived = append([]models.Todo{}, archived...)
+	trashed = append([]models.Todo{}, trashed...)
+
+	for _, change := range changes {
+		target, inArchive, inTrash := change.After, change.AfterArchived, change.AfterTrashed
+		if undo {
+			target, inArchive, inTrash = change.Before, change.BeforeArchived, change.BeforeTrashed
+		}
+
+		// The snapshot goes to exactly one file (or none when target is nil)
+		switch {
+		case target == nil:
+			todos = removeTodo(todos, change.ID)
+			archived = removeTodo(archived, change.ID)
+			trashed = removeTodo(trashed, change.ID)
+		case inArchive:
+			todos = removeTodo(todos, change.ID)
+			trashed = removeTodo(trashed, change.ID)
+			archived = upsertTodo(archived, *target)
+		case inTrash:
+			todos = removeTodo(todos, change.ID)
+			archived = removeTodo(archived, change.ID)
+			trashed = upsertTodo(trashed, *target)
+		default:
+			archived = removeTodo(archived, change.ID)
+			trashed = removeTodo(trashed, change.ID)
+			todos = upsertTodo(todos, *target)
+		}
+	}
+
+	return todos, archived, trashed
+}
+
+// ApplyEntryChanges rewinds (undo=true) or replays (undo=false) entry changes
+func ApplyEntryChanges(entries []models.Entry, changes []models.EntryChange, undo bool) []models.Entry {
+	entries = append([]models.Entry{}, entries...)
+
+	for _, change := range changes {
+		target := change.After
+		if undo {
+			target = change.Before
+		}
+
+		if target == nil {
+			kept := make([]models.Entry, 0, len(entries))
+			for _, entry := range entries {
+				if entry.ID != change.ID {
+					kept = append(kept, entry)
+				}
+			}
+			entries = kept
+			continue
+		}
+
+		found := false
+		for i := range entries {
+			if entries[i].ID == change.ID {
+				entries[i] = *target
+				found = true
+				break
+			}
+		}
+		if !found {
+			entries = append(entries, *target)
+		}
+	}
+
+	return entries
+}
+
+// RecordTouchesArchive reports whether undoing/redoing record needs archive.json
+func RecordTouchesArchive(record models.UndoRecord) bool {
+	for _, change := range record.Todos {
+		if change.AfterArchived || change.BeforeArchived {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordTouchesTrash reports whether undoing/redoing record needs trash.json
+func RecordTouchesTrash(record models.UndoRecord) bool {
+	for _, change := range record.Todos {
+		if change.AfterTrashed || change.BeforeTrashed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestPushAndMoveUndo(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	log := models.UndoLog{Redo: []models.UndoRecord{{Label: "stale redo", At: now}}}
+
+	log = PushUndo(log, models.UndoRecord{Label: "first", At: now})
+	log = PushUndo(log, models.UndoRecord{Label: "second", At: now})
+	if len(log.Undo) != 2 || len(log.Redo) != 0 {
+		t.Fatalf("Expected 2 undo and no redo after push, got %+v", log)
+	}
+
+	log = MoveUndoRecord(log, true)
+	if len(log.Undo) != 1 || len(log.Redo) != 1 || log.Redo[0].Label != "second" {
+		t.Fatalf("Expected second moved to redo, got %+v", log)
+	}
+
+	log = MoveUndoRecord(log, false)
+	if len(log.Undo) != 2 || len(log.Redo) != 0 || log.Undo[1].Label != "second" {
+		t.Fatalf("Expected second back on undo, got %+v", log)
+	}
+
+	// Moving from an empty stack is a no-op
+	empty := MoveUndoRecord(models.UndoLog{}, true)
+	if len(empty.Undo) != 0 || len(empty.Redo) != 0 {
+		t.Errorf("Expected empty log, got %+v", empty)
+	}
+}
+
+func TestPushUndoCapsStack(t *testing.T) {
+	log := models.UndoLog{}
+	for i := 0; i < MaxUndoRecords+5; i++ {
+		log = PushUndo(log, models.UndoRecord{Label: "x"})
+	}
+	if len(log.Undo) != MaxUndoRecords {
+		t.Errorf("Expected %d records, got %d", MaxUndoRecords, len(log.Undo))
+	}
+}
+
+func TestPruneUndoLog(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	log := models.UndoLog{
+		Undo: []models.UndoRecord{
+			{Label: "yesterday", At: now.Add(-24 * time.Hour)},
+			{Label: "recent", At: now.Add(-time.Hour)},
+		},
+		Redo: []models.UndoRecord{{Label: "old redo", At: now.Add(-UndoWindow - time.Minute)}},
+	}
+
+	pruned := PruneUndoLog(log, now)
+	if len(pruned.Undo) != 1 || pruned.Undo[0].Label != "recent" {
+		t.Errorf("Expected only recent undo record, got %+v", pruned.Undo)
+	}
+	if len(pruned.Redo) != 0 {
+		t.Errorf("Expected redo pruned, got %+v", pruned.Redo)
+	}
+}
+
+func TestApplyTodoChanges(t *testing.T) {
+	open := models.Todo{ID: "1", Title: "Toggle me", Status: "open"}
+	next := models.Todo{ID: "1", Title: "Toggle me", Status: "next"}
+	created := models.Todo{ID: "2", Title: "New"}
+	archivedBefore := models.Todo{ID: "3", Title: "Archive me", Status: "done"}
+	archivedAfter := archivedBefore
+	now := time.Now()
+	archivedAfter.ArchivedAt = &now
+
+	changes := []models.TodoChange{
+		{ID: "1", Before: &open, After: &next},
+		{ID: "2", After: &created},
+		{ID: "3", Before: &archivedBefore, After: &archivedAfter, AfterArchived: true},
+	}
+
+	// State after the actions
+	todos := []models.Todo{next, created}
+	archived := []models.Todo{archivedAfter}
+
+	undoneTodos, undoneArchive, _ := ApplyTodoChanges(todos, archived, nil, changes, true)
+	if len(undoneTodos) != 2 || undoneTodos[0].Status != "open" || undoneTodos[1].ID != "3" {
+		t.Errorf("Unexpected todos after undo: %+v", undoneTodos)
+	}
+	if len(undoneArchive) != 0 {
+		t.Errorf("Expected archive emptied by undo, got %+v", undoneArchive)
+	}
+	if len(todos) != 2 || todos[0].Status != "next" {
+		t.Error("ApplyTodoChanges must not modify the input slices")
+	}
+
+	redoneTodos, redoneArchive, _ := ApplyTodoChanges(undoneTodos, undoneArchive, nil, changes, false)
+	if len(redoneTodos) != 2 || redoneTodos[0].Status != "next" || redoneTodos[1].ID != "2" {
+		t.Errorf("Unexpected todos after redo: %+v", redoneTodos)
+	}
+	if len(redoneArchive) != 1 || redoneArchive[0].ID != "3" {
+		t.Errorf("Expected todo 3 archived again, got %+v", redoneArchive)
+	}
+
+	// Idempotent: undoing twice gives the same state
+	again, _, _ := ApplyTodoChanges(undoneTodos, undoneArchive, nil, changes, true)
+	if len(again) != len(undoneTodos) {
+		t.Errorf("Expected undo to be idempotent, got %+v", again)
+	}
+}
+
+func TestApplyTodoChangesRestore(t *testing.T) {
+	now := time.Now()
+	inArchive := models.Todo{ID: "1", Title: "Old", Status: "done", ArchivedAt: &now}
+	restored := models.Todo{ID: "1", Title: "Old", Status: "done", RestoredAt: &now}
+	changes := []models.TodoChange{{ID: "1", Before: &inArchive, After: &restored, BeforeArchived: true}}
+
+	// Undoing a restore puts the todo back in the archive
+	todos, archived, _ := ApplyTodoChanges([]models.Todo{restored}, nil, nil, changes, true)
+	if len(todos) != 0 || len(archived) != 1 || archived[0].ArchivedAt == nil {
+		t.Errorf("Expected todo back in archive, got todos %+v archive %+v", todos, archived)
+	}
+
+	// Redo restores it again
+	todos, archived, _ = ApplyTodoChanges(todos, archived, nil, changes, false)
+	if len(todos) != 1 || len(archived) != 0 {
+		t.Errorf("Expected todo restored, got todos %+v archive %+v", todos, archived)
+	}
+
+	if !RecordTouchesArchive(models.UndoRecord{Todos: changes}) {
+		t.Error("Expected a restore record to touch the archive")
+	}
+}
+
+func TestApplyTodoChangesTrash(t *testing.T) {
+	now := time.Now()
+	active := models.Todo{ID: "1", Title: "test item", Status: "open"}
+	deleted := active
+	deleted.DeletedAt = &now
+	changes := []models.TodoChange{{ID: "1", Before: &active, After: &deleted, AfterTrashed: true}}
+
+	// Undoing a delete brings the todo back from the trash
+	todos, archived, trashed := ApplyTodoChanges(nil, nil, []models.Todo{deleted}, changes, true)
+	if len(todos) != 1 || todos[0].DeletedAt != nil || len(trashed) != 0 || len(archived) != 0 {
+		t.Errorf("Expected todo restored from trash, got todos %+v trash %+v", todos, trashed)
+	}
+
+	// Redo deletes it again
+	todos, _, trashed = ApplyTodoChanges(todos, nil, trashed, changes, false)
+	if len(todos) != 0 || len(trashed) != 1 {
+		t.Errorf("Expected todo back in trash, got todos %+v trash %+v", todos, trashed)
+	}
+
+	record := models.UndoRecord{Todos: changes}
+	if !RecordTouchesTrash(record) || RecordTouchesArchive(record) {
+		t.Error("Expected a delete record to touch the trash only")
+	}
+}
+
+func TestApplyEntryChanges(t *testing.T) {
+	v1 := models.Entry{ID: "1", Title: "Draft"}
+	v2 := models.Entry{ID: "1", Title: "Final"}
+	other := models.Entry{ID: "2", Title: "Other"}
+
+	// Re-save of an existing entry
+	entries := ApplyEntryChanges([]models.Entry{v2, other}, []models.EntryChange{{ID: "1", Before: &v1, After: &v2}}, true)
+	if len(entries) != 2 || entries[0].Title != "Draft" {
+		t.Errorf("Expected entry restored to Draft, got %+v", entries)
+	}
+
+	// New entry: undo removes it, redo brings it back
+	entries = ApplyEntryChanges([]models.Entry{other, v1}, []models.EntryChange{{ID: "1", After: &v1}}, true)
+	if len(entries) != 1 || entries[0].ID != "2" {
+		t.Errorf("Expected new entry removed, got %+v", entries)
+	}
+	entries = ApplyEntryChanges(entries, []models.EntryChange{{ID: "1", After: &v1}}, false)
+	if len(entries) != 2 {
+		t.Errorf("Expected entry restored by redo, got %+v", entries)
+	}
+}
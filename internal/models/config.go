@@ -0,0 +1,14 @@
+package models
+
+// Config holds user settings stored in config.json
+type Config struct {
+	TagAliases map[string]string `json:"tag_aliases,omitempty"` // alias -> canonical tag (no @ prefix)
+
+	ArchiveDoneAfterDays *int `json:"archive_done_after_days,omitempty"` // Auto-archive done todos after N days (nil = default 14, 0 = never)
+	PurgeTrashAfterDays  *int `json:"purge_trash_after_days,omitempty"`  // Permanently delete trashed todos after N days (nil = default 30, 0 = never)
+
+	PomodoroWorkMinutes  int `json:"pomodoro_work_minutes,omitempty"`  // Focus mode work interval (0 = default 25)
+	PomodoroBreakMinutes int `json:"pomodoro_break_minutes,omitempty"` // Focus mode break interval (0 = default 5)
+
+	SyncRemote string `json:"sync_remote,omitempty"` // git remote "amos sync" pulls from and pushes to (URL or path)
+}
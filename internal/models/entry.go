@@ -8,6 +8,8 @@ type Entry struct {
 	Title     string    `json:"title"`
 	Body      string    `json:"body"`
 	Tags      []string  `json:"tags"`
+	People    []string  `json:"people,omitempty"` // +mentions (lowercase, no + prefix)
 	Timestamp time.Time `json:"timestamp"`
 	TodoIDs   []string  `json:"todo_ids,omitempty"` // IDs of todos created in this entry
+	Private   bool      `json:"private,omitempty"`  // Tagged @private: hidden on screen until revealed, left out of exports
 }
@@ -8,6 +8,25 @@ type Todo struct {
 	Title     string    `json:"title"`
 	Status    string    `json:"status"` // "open", "next", or "done"
 	Tags      []string  `json:"tags"`
+	People    []string  `json:"people,omitempty"` // +mentions (lowercase, no + prefix)
 	CreatedAt time.Time `json:"created_at"`
 	EntryID   *string   `json:"entry_id,omitempty"` // Pointer - nil if standalone
+
+	CompletedAt *time.Time `json:"completed_at,omitempty"` // When status last became "done" (nil if not done)
+	Due         *time.Time `json:"due,omitempty"`          // Deadline day (midnight), from due:<day>
+	Scheduled   *time.Time `json:"scheduled,omitempty"`    // Day planned to work on it (midnight), from sched:<day>
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`  // When moved to archive.json (nil while active)
+	RestoredAt  *time.Time `json:"restored_at,omitempty"`  // Last restore from archive.json (auto-archive waits again from here)
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`   // When moved to trash.json (nil unless deleted)
+
+	Notes string `json:"notes,omitempty"` // Multi-line context (links, commands, "- [ ]" checklists), edited in the detail view
+
+	TimeLog   []TimeInterval `json:"time_log,omitempty"`  // Tracked work, oldest first (the last interval is open while the timer runs)
+	Pomodoros []time.Time    `json:"pomodoros,omitempty"` // When each completed focus-mode work interval ended
+}
+
+// TimeInterval is one stretch of tracked work on a todo
+type TimeInterval struct {
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end,omitempty"` // nil while the timer is running
 }
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// TodoChange records one todo before and after a mutation
+// Before nil = the todo was created; After nil = the todo was removed from todos.json
+// The *Archived/*Trashed flags say which file a snapshot lives in (default todos.json)
+type TodoChange struct {
+	ID             string `json:"id"`
+	Before         *Todo  `json:"before,omitempty"`
+	After          *Todo  `json:"after,omitempty"`
+	AfterArchived  bool   `json:"after_archived,omitempty"`  // After lives in archive.json instead of todos.json
+	BeforeArchived bool   `json:"before_archived,omitempty"` // Before lives in archive.json (restores from the archive)
+	AfterTrashed   bool   `json:"after_trashed,omitempty"`   // After lives in trash.json (deletes)
+	BeforeTrashed  bool   `json:"before_trashed,omitempty"`  // Before lives in trash.json (restores from the trash)
+}
+
+// EntryChange records one entry before and after a save (Before nil = new entry)
+type EntryChange struct {
+	ID     string `json:"id"`
+	Before *Entry `json:"before,omitempty"`
+	After  *Entry `json:"after,omitempty"`
+}
+
+// UndoRecord is one undoable action (a toggle, a save, a bulk edit)
+type UndoRecord struct {
+	Label   string        `json:"label"` // Shown in the status bar ("undid ...")
+	At      time.Time     `json:"at"`
+	Todos   []TodoChange  `json:"todos,omitempty"`
+	Entries []EntryChange `json:"entries,omitempty"`
+}
+
+// UndoLog is the persisted undo/redo history (most recent last)
+type UndoLog struct {
+	Undo []UndoRecord `json:"undo"`
+	Redo []UndoRecord `json:"redo"`
+}
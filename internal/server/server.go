@@ -0,0 +1,413 @@
+// Package server implements "amos serve": a local HTTP JSON API over entries and todos
+// for editor plugins and scripts. It reads and writes through the storage package,
+// so its writes take the same storage lock as a running TUI.
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/internal/storage"
+	"github.com/google/uuid"
+)
+
+// DefaultAddr is where "amos serve" listens without --addr or --socket
+const DefaultAddr = "127.0.0.1:7373"
+
+// maxBodyBytes caps request bodies (entries are typed by hand)
+const maxBodyBytes = 1 << 20
+
+// Server serves the API; every request must carry "Authorization: Bearer <token>"
+type Server struct {
+	token string
+	now   func() time.Time
+}
+
+// New returns a server that accepts the given bearer token
+func New(token string) *Server {
+	return &Server{token: token, now: time.Now}
+}
+
+// GenerateToken returns a random 32-byte token, hex encoded
+func GenerateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// CheckLoopback rejects listen addresses that aren't on the loopback interface
+// ("localhost:7373", "127.0.0.1:7373" and "[::1]:7373" are fine; ":7373" is not)
+func CheckLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("refusing to listen on %q: use a loopback address or --socket", addr)
+	}
+	return nil
+}
+
+// Listen opens the listener for "amos serve": a Unix socket (mode 0600) when socket
+// is set, otherwise TCP on addr, which must be a loopback address
+func Listen(addr, socket string) (net.Listener, error) {
+	if socket == "" {
+		if err := CheckLoopback(addr); err != nil {
+			return nil, err
+		}
+		return net.Listen("tcp", addr)
+	}
+
+	// A socket left behind by a crashed server would make Listen fail
+	if info, err := os.Lstat(socket); err == nil && info.Mode()&os.ModeSocket != 0 {
+		os.Remove(socket)
+	}
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(socket, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// Handler returns the API routes wrapped in token authentication
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /entries", s.listEntries)
+	mux.HandleFunc("GET /entries/{id}", s.getEntry)
+	mux.HandleFunc("POST /entries", s.createEntry)
+	mux.HandleFunc("GET /todos", s.listTodos)
+	mux.HandleFunc("GET /todos/{id}", s.getTodo)
+	mux.HandleFunc("POST /todos", s.createTodo)
+	mux.HandleFunc("PATCH /todos/{id}", s.updateTodo)
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without the bearer token
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeError(w, http.StatusUnauthorized, "missing or invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseFilter parses the ?filter= query with the TUI's unified filter syntax
+// ("@work +alice last 7 days \"deploy\"")
+func parseFilter(r *http.Request) (helpers.FilterResult, error) {
+	filter := helpers.ParseFilterInput(r.URL.Query().Get("filter"))
+	if len(filter.Errors) > 0 {
+		return filter, errors.New(strings.Join(filter.Errors, "; "))
+	}
+	return filter, nil
+}
+
+// listEntries handles GET /entries?filter=...&private=true (newest first)
+// @private entries are left out unless private=true; GET /entries/{id} returns them when asked by ID
+func (s *Server) listEntries(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := storage.LoadEntries()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("private") != "true" {
+		entries = helpers.WithoutPrivateEntries(entries)
+	}
+
+	// Same order as the entries list: date, then tags, then people, then text
+	filtered := helpers.FilterEntriesByDateRange(entries, filter.Date)
+	filtered = helpers.FilterEntriesByTags(filtered, filter.Tags)
+	filtered = helpers.FilterEntriesByPeople(filtered, filter.People)
+	filtered = helpers.FilterEntriesByText(filtered, filter.Text)
+
+	writeJSON(w, http.StatusOK, map[string]any{"entries": nonNil(helpers.SortEntriesForDisplay(filtered))})
+}
+
+// getEntry handles GET /entries/{id} (the entry plus its linked todos still in todos.json)
+func (s *Server) getEntry(w http.ResponseWriter, r *http.Request) {
+	entries, err := storage.LoadEntries()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	for _, entry := range entries {
+		if entry.ID != id {
+			continue
+		}
+		todos, err := storage.LoadTodos()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"entry": entry,
+			"todos": nonNil(helpers.FilterTodosByEntry(todos, entry.ID)),
+		})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "entry not found")
+}
+
+// createEntry handles POST /entries {"content": "..."}
+// Content is parsed like the entry editor: first line is the title, @tags, +people
+// and "!todo" lines (which become linked todos)
+func (s *Server) createEntry(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Content string `json:"content"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	title, body := helpers.ParseEntryContent(req.Content)
+	if strings.TrimSpace(title) == "" {
+		writeError(w, http.StatusBadRequest, "content cannot be empty")
+		return
+	}
+
+	cfg, err := storage.LoadConfig()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	now := s.now()
+	entry := models.Entry{
+		ID:        uuid.New().String(),
+		Title:     title,
+		Body:      body,
+		Tags:      helpers.ApplyTagAliases(helpers.ExtractTags(title+" "+body), cfg.TagAliases),
+		People:    helpers.ExtractPeople(title + "\n" + body),
+		Timestamp: now,
+	}
+	entry.Private = helpers.IsPrivateTagged(entry.Tags)
+
+	// The entry and the todos it links are saved together (one locked write, like the editor)
+	todos := []models.Todo{}
+	for _, todoTitle := range helpers.ExtractTodos(req.Content) {
+		due, scheduled := helpers.ExtractSchedule(todoTitle, now)
+		todo := models.Todo{
+			ID:        uuid.New().String(),
+			Title:     todoTitle,
+			Status:    "open",
+			Tags:      helpers.ApplyTagAliases(helpers.ExtractTags(todoTitle), cfg.TagAliases),
+			People:    helpers.ExtractPeople(todoTitle),
+			CreatedAt: now,
+			EntryID:   &entry.ID,
+			Due:       due,
+			Scheduled: scheduled,
+		}
+		todos = append(todos, todo)
+		entry.TodoIDs = append(entry.TodoIDs, todo.ID)
+	}
+
+	if _, err := storage.SaveEntryWithTodos(entry, todos); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{"entry": entry, "todos": todos})
+}
+
+// listTodos handles GET /todos?filter=...&status=... (in todos list order)
+func (s *Server) listTodos(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	todos, err := storage.LoadTodos()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Todos of @private entries are left out unless ?private=true (like GET /entries)
+	if r.URL.Query().Get("private") != "true" {
+		entries, err := storage.LoadEntries()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		todos = helpers.WithoutPrivateTodos(todos, entries)
+	}
+
+	// Same order as the todos list: date, then tags, then people, then text
+	filtered := helpers.FilterTodosByDateRange(todos, filter.Date)
+	filtered = helpers.FilterTodosByTags(filtered, filter.Tags)
+	filtered = helpers.FilterTodosByPeople(filtered, filter.People)
+	filtered = helpers.FilterTodosByText(filtered, filter.Text)
+
+	if status := strings.ToLower(r.URL.Query().Get("status")); status != "" {
+		byStatus := []models.Todo{}
+		for _, todo := range filtered {
+			if todo.Status == status {
+				byStatus = append(byStatus, todo)
+			}
+		}
+		filtered = byStatus
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"todos": nonNil(helpers.SortTodosForDisplay(filtered))})
+}
+
+// getTodo handles GET /todos/{id}
+func (s *Server) getTodo(w http.ResponseWriter, r *http.Request) {
+	todos, err := storage.LoadTodos()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	for _, todo := range todos {
+		if todo.ID == id {
+			writeJSON(w, http.StatusOK, map[string]any{"todo": todo})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "todo not found")
+}
+
+// createTodo handles POST /todos {"title": "..."} (a standalone todo, like the add todo screen)
+func (s *Server) createTodo(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Title string `json:"title"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		writeError(w, http.StatusBadRequest, "title cannot be empty")
+		return
+	}
+
+	cfg, err := storage.LoadConfig()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	now := s.now()
+	todo := helpers.RetitleTodo(models.Todo{
+		ID:        uuid.New().String(),
+		Status:    "open",
+		CreatedAt: now,
+	}, req.Title, cfg.TagAliases)
+	todo.Due, todo.Scheduled = helpers.ExtractSchedule(todo.Title, now)
+
+	if err := storage.SaveTodo(todo); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{"todo": todo})
+}
+
+// updateTodo handles PATCH /todos/{id} {"status": "done"}
+// The status is validated and applied like the bulk "status <name>" command
+func (s *Server) updateTodo(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Status string `json:"status"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.Status) == "" {
+		writeError(w, http.StatusBadRequest, "status cannot be empty")
+		return
+	}
+	action, err := helpers.ParseBulkCommand("status "+req.Status, s.now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	todo, err := storage.UpdateTodo(id, func(todo *models.Todo) error {
+		updated, _ := helpers.ApplyBulkAction([]models.Todo{*todo}, map[string]bool{id: true}, action, s.now())
+		*todo = updated[0]
+		return nil
+	})
+	if errors.Is(err, storage.ErrTodoNotFound) {
+		writeError(w, http.StatusNotFound, "todo not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"todo": todo})
+}
+
+// decodeBody decodes a JSON request body into v, writing a 400 on failure
+// Unknown fields are rejected so typos ("stauts") don't silently do nothing
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = errors.New("empty request body")
+		}
+		writeError(w, http.StatusBadRequest, "invalid JSON: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes {"error": message}
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// nonNil keeps empty lists as [] rather than null in responses
+func nonNil[T any](list []T) []T {
+	if list == nil {
+		return []T{}
+	}
+	return list
+}
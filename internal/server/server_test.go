@@ -0,0 +1,325 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/internal/storage"
+)
+
+const testToken = "secret"
+
+// setupServer points storage at a temp home and returns a test server
+func setupServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	t.Cleanup(func() { os.Setenv("HOME", originalHome) })
+
+	ts := httptest.NewServer(New(testToken).Handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// do sends an authenticated request and decodes the JSON response into out (if non-nil)
+func do(t *testing.T, ts *httptest.Server, method, path, body string, out any) int {
+	t.Helper()
+	req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() failed: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("decoding %s %s response failed: %v", method, path, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestAuthentication(t *testing.T) {
+	ts := setupServer(t)
+
+	tests := []struct {
+		name   string
+		header string
+	}{
+		{"missing", ""},
+		{"wrong token", "Bearer nope"},
+		{"not bearer", "Basic " + testToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", ts.URL+"/todos", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("GET /todos failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusUnauthorized {
+				t.Errorf("Expected 401, got %d", resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestCreateAndGetEntry(t *testing.T) {
+	ts := setupServer(t)
+
+	var created struct {
+		Entry models.Entry  `json:"entry"`
+		Todos []models.Todo `json:"todos"`
+	}
+	status := do(t, ts, "POST", "/entries", `{"content": "Standup @work\nWith +alice\n!todo ship release @work"}`, &created)
+	if status != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", status)
+	}
+	if created.Entry.Title != "Standup @work" {
+		t.Errorf("Expected title 'Standup @work', got %q", created.Entry.Title)
+	}
+	if len(created.Todos) != 1 || created.Todos[0].Status != "open" {
+		t.Fatalf("Expected one open todo, got %+v", created.Todos)
+	}
+	if created.Todos[0].EntryID == nil || *created.Todos[0].EntryID != created.Entry.ID {
+		t.Errorf("Expected todo linked to entry %s", created.Entry.ID)
+	}
+
+	var got struct {
+		Entry models.Entry  `json:"entry"`
+		Todos []models.Todo `json:"todos"`
+	}
+	if status := do(t, ts, "GET", "/entries/"+created.Entry.ID, "", &got); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if got.Entry.ID != created.Entry.ID || len(got.Todos) != 1 {
+		t.Errorf("Expected the entry with its todo, got %+v", got)
+	}
+
+	if status := do(t, ts, "GET", "/entries/missing", "", nil); status != http.StatusNotFound {
+		t.Errorf("Expected 404 for a missing entry, got %d", status)
+	}
+	if status := do(t, ts, "POST", "/entries", `{"content": "  "}`, nil); status != http.StatusBadRequest {
+		t.Errorf("Expected 400 for empty content, got %d", status)
+	}
+}
+
+func TestListEntriesFilter(t *testing.T) {
+	ts := setupServer(t)
+
+	now := time.Now()
+	entries := []models.Entry{
+		{ID: "1", Title: "Work", Tags: []string{"work"}, Timestamp: now.Add(-time.Hour)},
+		{ID: "2", Title: "Home", Tags: []string{"home"}, Timestamp: now},
+		{ID: "3", Title: "Work again", Tags: []string{"work"}, Timestamp: now},
+	}
+	if err := storage.SaveEntries(entries); err != nil {
+		t.Fatalf("SaveEntries() failed: %v", err)
+	}
+
+	var resp struct {
+		Entries []models.Entry `json:"entries"`
+	}
+	if status := do(t, ts, "GET", "/entries?filter=%40work", "", &resp); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if len(resp.Entries) != 2 || resp.Entries[0].ID != "3" {
+		t.Errorf("Expected entries 3 and 1 (newest first), got %+v", resp.Entries)
+	}
+
+	if status := do(t, ts, "GET", "/entries?filter=bogus", "", nil); status != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an invalid filter, got %d", status)
+	}
+}
+
+func TestListEntriesPrivate(t *testing.T) {
+	ts := setupServer(t)
+
+	var created struct {
+		Entry models.Entry `json:"entry"`
+	}
+	if status := do(t, ts, "POST", "/entries", `{"content": "Checkup @private\nResults"}`, &created); status != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", status)
+	}
+	if !created.Entry.Private {
+		t.Error("Expected an @private entry to be flagged private")
+	}
+	if err := storage.SaveEntry(models.Entry{ID: "public", Title: "Standup", Timestamp: time.Now()}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+
+	var resp struct {
+		Entries []models.Entry `json:"entries"`
+	}
+	do(t, ts, "GET", "/entries", "", &resp)
+	if len(resp.Entries) != 1 || resp.Entries[0].ID != "public" {
+		t.Errorf("Expected only the public entry, got %+v", resp.Entries)
+	}
+	do(t, ts, "GET", "/entries?private=true", "", &resp)
+	if len(resp.Entries) != 2 {
+		t.Errorf("Expected both entries with private=true, got %+v", resp.Entries)
+	}
+	if status := do(t, ts, "GET", "/entries/"+created.Entry.ID, "", nil); status != http.StatusOK {
+		t.Errorf("Expected the private entry by ID, got %d", status)
+	}
+}
+
+func TestListTodosPrivate(t *testing.T) {
+	ts := setupServer(t)
+
+	if status := do(t, ts, "POST", "/entries", `{"content": "Checkup @private\n!todo Call +drsmith @health"}`, nil); status != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", status)
+	}
+	if status := do(t, ts, "POST", "/todos", `{"title": "Buy milk"}`, nil); status != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", status)
+	}
+
+	var resp struct {
+		Todos []models.Todo `json:"todos"`
+	}
+	do(t, ts, "GET", "/todos", "", &resp)
+	if len(resp.Todos) != 1 || resp.Todos[0].Title != "Buy milk" {
+		t.Errorf("Expected only the standalone todo, got %+v", resp.Todos)
+	}
+	do(t, ts, "GET", "/todos?filter=%2Bdrsmith", "", &resp)
+	if len(resp.Todos) != 0 {
+		t.Errorf("Expected no todos for a person only in a private entry, got %+v", resp.Todos)
+	}
+	do(t, ts, "GET", "/todos?private=true", "", &resp)
+	if len(resp.Todos) != 2 {
+		t.Errorf("Expected both todos with private=true, got %+v", resp.Todos)
+	}
+}
+
+func TestTodos(t *testing.T) {
+	ts := setupServer(t)
+
+	var created struct {
+		Todo models.Todo `json:"todo"`
+	}
+	if status := do(t, ts, "POST", "/todos", `{"title": "Call +bob @phone"}`, &created); status != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", status)
+	}
+	if created.Todo.Status != "open" || len(created.Todo.Tags) != 1 || created.Todo.Tags[0] != "phone" {
+		t.Errorf("Expected an open todo tagged phone, got %+v", created.Todo)
+	}
+	if err := storage.SaveTodo(models.Todo{ID: "other", Title: "Other", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+
+	var updated struct {
+		Todo models.Todo `json:"todo"`
+	}
+	if status := do(t, ts, "PATCH", "/todos/"+created.Todo.ID, `{"status": "done"}`, &updated); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if updated.Todo.Status != "done" || updated.Todo.CompletedAt == nil {
+		t.Errorf("Expected a done todo with CompletedAt, got %+v", updated.Todo)
+	}
+
+	var list struct {
+		Todos []models.Todo `json:"todos"`
+	}
+	if status := do(t, ts, "GET", "/todos?status=done&filter=%2Bbob", "", &list); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if len(list.Todos) != 1 || list.Todos[0].ID != created.Todo.ID {
+		t.Errorf("Expected only the done todo, got %+v", list.Todos)
+	}
+
+	var got struct {
+		Todo models.Todo `json:"todo"`
+	}
+	if status := do(t, ts, "GET", "/todos/other", "", &got); status != http.StatusOK || got.Todo.ID != "other" {
+		t.Errorf("Expected todo other, got %d %+v", status, got.Todo)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{"missing todo", "GET", "/todos/missing", "", http.StatusNotFound},
+		{"patch missing todo", "PATCH", "/todos/missing", `{"status": "done"}`, http.StatusNotFound},
+		{"unknown field", "PATCH", "/todos/other", `{"stauts": "done"}`, http.StatusBadRequest},
+		{"empty status", "PATCH", "/todos/other", `{"status": ""}`, http.StatusBadRequest},
+		{"bad status", "PATCH", "/todos/other", `{"status": "two words"}`, http.StatusBadRequest},
+		{"empty body", "POST", "/todos", "", http.StatusBadRequest},
+		{"empty title", "POST", "/todos", `{"title": ""}`, http.StatusBadRequest},
+		{"wrong method", "DELETE", "/todos/other", "", http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := do(t, ts, tt.method, tt.path, tt.body, nil); status != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, status)
+			}
+		})
+	}
+}
+
+func TestCheckLoopback(t *testing.T) {
+	tests := []struct {
+		addr    string
+		wantErr bool
+	}{
+		{"127.0.0.1:7373", false},
+		{"localhost:7373", false},
+		{"[::1]:7373", false},
+		{":7373", true},
+		{"0.0.0.0:7373", true},
+		{"192.168.1.5:7373", true},
+		{"no-port", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			err := CheckLoopback(tt.addr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckLoopback(%q) error = %v, wantErr %v", tt.addr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListenSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "amos.sock")
+
+	listener, err := Listen("", socket)
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	defer listener.Close()
+
+	info, err := os.Stat(socket)
+	if err != nil {
+		t.Fatalf("Stat() failed: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected socket mode 0600, got %o", info.Mode().Perm())
+	}
+
+	if _, err := Listen("0.0.0.0:0", ""); err == nil {
+		t.Error("Expected Listen() to refuse a non-loopback address")
+	}
+}
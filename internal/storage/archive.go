@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// archiveFile holds archived todos; it is only read when archiving or browsing the archive
+const archiveFile = "archive.json"
+
+// LoadArchivedTodos loads all archived todos from archive.json
+func LoadArchivedTodos() ([]models.Todo, error) {
+	return loadTodoFile(archiveFile)
+}
+
+// SaveArchivedTodos saves all archived todos to archive.json
+func SaveArchivedTodos(todos []models.Todo) error {
+	return withLock(func() error { return saveTodoFile(archiveFile, todos) })
+}
+
+// loadTodoFile loads a todo list kept beside todos.json (archive.json, trash.json)
+// Returns an empty slice if the file doesn't exist yet
+func loadTodoFile(name string) ([]models.Todo, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, name)
+
+	// If file doesn't exist, return empty slice
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return []models.Todo{}, nil
+	}
+
+	data, err := readDataFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var todos []models.Todo
+	if err := json.Unmarshal(data, &todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// saveTodoFile saves a todo list kept beside todos.json (archive.json, trash.json)
+func saveTodoFile(name string, todos []models.Todo) error {
+	if err := EnsureAmosDir(); err != nil {
+		return err
+	}
+
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, name)
+
+	data, err := json.MarshalIndent(todos, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeDataFile(path, data)
+}
+
+// ArchiveTodos moves the todos with the given IDs from todos.json to archive.json
+// A running timer is stopped at now: no interval is left open in the archive
+// Archive is written first so a failure part-way never loses todos (worst case: a duplicate)
+// Returns how many todos were archived
+func ArchiveTodos(ids map[string]bool, now time.Time) (int, error) {
+	var n int
+	err := withLock(func() (err error) {
+		n, err = archiveTodos(ids, now)
+		return err
+	})
+	return n, err
+}
+
+// archiveTodos is ArchiveTodos without taking the storage lock
+func archiveTodos(ids map[string]bool, now time.Time) (int, error) {
+	todos, err := LoadTodos()
+	if err != nil {
+		return 0, err
+	}
+	archived, err := LoadArchivedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]models.Todo, 0, len(todos))
+	moved := 0
+	for _, todo := range todos {
+		if !ids[todo.ID] {
+			remaining = append(remaining, todo)
+			continue
+		}
+		todo = helpers.StopTimer(todo, now)
+		archivedAt := now
+		todo.ArchivedAt = &archivedAt
+		archived = append(archived, todo)
+		moved++
+	}
+
+	if moved == 0 {
+		return 0, nil
+	}
+
+	if err := saveTodoFile(archiveFile, archived); err != nil {
+		return 0, err
+	}
+	if err := saveTodos(remaining); err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+// RestoreTodos moves the todos with the given IDs from archive.json back to todos.json
+// Todos are written first so a failure part-way never loses todos (worst case: a duplicate)
+// Returns how many todos were restored
+func RestoreTodos(ids map[string]bool, now time.Time) (int, error) {
+	var n int
+	err := withLock(func() (err error) {
+		n, err = restoreTodos(ids, now)
+		return err
+	})
+	return n, err
+}
+
+// restoreTodos is RestoreTodos without taking the storage lock
+func restoreTodos(ids map[string]bool, now time.Time) (int, error) {
+	todos, err := LoadTodos()
+	if err != nil {
+		return 0, err
+	}
+	archived, err := LoadArchivedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]models.Todo, 0, len(archived))
+	restored := 0
+	for _, todo := range archived {
+		if !ids[todo.ID] {
+			remaining = append(remaining, todo)
+			continue
+		}
+		restoredAt := now
+		todo.ArchivedAt = nil
+		todo.RestoredAt = &restoredAt
+		todos = append(todos, todo)
+		restored++
+	}
+
+	if restored == 0 {
+		return 0, nil
+	}
+
+	if err := saveTodos(todos); err != nil {
+		return 0, err
+	}
+	if err := saveTodoFile(archiveFile, remaining); err != nil {
+		return 0, err
+	}
+
+	return restored, nil
+}
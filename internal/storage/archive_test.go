@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestArchiveTodos(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	todos := []models.Todo{
+		{ID: "1", Title: "Keep", Status: "open"},
+		{ID: "2", Title: "Archive me", Status: "done"},
+		{ID: "3", Title: "Archive me too", Status: "done"},
+	}
+	if err := SaveTodos(todos); err != nil {
+		t.Fatalf("SaveTodos() failed: %v", err)
+	}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	moved, err := ArchiveTodos(map[string]bool{"2": true, "3": true, "missing": true}, now)
+	if err != nil {
+		t.Fatalf("ArchiveTodos() failed: %v", err)
+	}
+	if moved != 2 {
+		t.Errorf("Expected 2 archived, got %d", moved)
+	}
+
+	active, err := LoadTodos()
+	if err != nil {
+		t.Fatalf("LoadTodos() failed: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != "1" {
+		t.Errorf("Expected only todo 1 to stay active, got %v", active)
+	}
+
+	archived, err := LoadArchivedTodos()
+	if err != nil {
+		t.Fatalf("LoadArchivedTodos() failed: %v", err)
+	}
+	if len(archived) != 2 {
+		t.Fatalf("Expected 2 archived todos, got %d", len(archived))
+	}
+	for _, todo := range archived {
+		if todo.ArchivedAt == nil || !todo.ArchivedAt.Equal(now) {
+			t.Errorf("Expected ArchivedAt %v on %s, got %v", now, todo.ID, todo.ArchivedAt)
+		}
+	}
+}
+
+func TestArchiveTodosStopsTimer(t *testing.T) {
+	useHome(t, t.TempDir())
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	todos := []models.Todo{{ID: "1", Title: "Tracked", Status: "done", TimeLog: []models.TimeInterval{{Start: start}}}}
+	if err := SaveTodos(todos); err != nil {
+		t.Fatalf("SaveTodos() failed: %v", err)
+	}
+
+	now := start.Add(2 * time.Hour)
+	if _, err := ArchiveTodos(map[string]bool{"1": true}, now); err != nil {
+		t.Fatalf("ArchiveTodos() failed: %v", err)
+	}
+	archived, err := LoadArchivedTodos()
+	if err != nil || len(archived) != 1 {
+		t.Fatalf("LoadArchivedTodos() = %+v, %v", archived, err)
+	}
+	if end := archived[0].TimeLog[0].End; end == nil || !end.Equal(now) {
+		t.Errorf("Expected the interval closed at %v, got %v", now, end)
+	}
+}
+
+func TestLoadArchivedTodosMissingFile(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	archived, err := LoadArchivedTodos()
+	if err != nil {
+		t.Fatalf("LoadArchivedTodos() failed: %v", err)
+	}
+	if len(archived) != 0 {
+		t.Errorf("Expected empty archive, got %d todos", len(archived))
+	}
+}
+
+func TestRestoreTodos(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	archivedAt := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	if err := SaveTodos([]models.Todo{{ID: "1", Title: "Active", Status: "open"}}); err != nil {
+		t.Fatalf("SaveTodos() failed: %v", err)
+	}
+	if err := SaveArchivedTodos([]models.Todo{
+		{ID: "2", Title: "Bring back", Status: "done", ArchivedAt: &archivedAt},
+		{ID: "3", Title: "Stay archived", Status: "done", ArchivedAt: &archivedAt},
+	}); err != nil {
+		t.Fatalf("SaveArchivedTodos() failed: %v", err)
+	}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	restored, err := RestoreTodos(map[string]bool{"2": true}, now)
+	if err != nil {
+		t.Fatalf("RestoreTodos() failed: %v", err)
+	}
+	if restored != 1 {
+		t.Errorf("Expected 1 restored, got %d", restored)
+	}
+
+	active, _ := LoadTodos()
+	if len(active) != 2 || active[1].ID != "2" {
+		t.Fatalf("Expected todo 2 appended to todos.json, got %v", active)
+	}
+	if active[1].ArchivedAt != nil || active[1].RestoredAt == nil || !active[1].RestoredAt.Equal(now) {
+		t.Errorf("Expected ArchivedAt cleared and RestoredAt set, got %+v", active[1])
+	}
+
+	archived, _ := LoadArchivedTodos()
+	if len(archived) != 1 || archived[0].ID != "3" {
+		t.Errorf("Expected only todo 3 left in archive, got %v", archived)
+	}
+}
@@ -0,0 +1,397 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	backupsDir     = "backups"             // Snapshots of the data files, one directory each
+	snapshotLayout = "2006-01-02T15-04-05" // Snapshot directory names (local time, sorts by age)
+	snapshotEvery  = time.Hour             // A write takes a snapshot first when the newest is older than this
+)
+
+// Retention: the newest snapshot of each hour, day and ISO week in these windows is kept
+const (
+	keepHourly = 24 // Hours
+	keepDaily  = 7  // Days
+	keepWeekly = 8  // Weeks
+)
+
+// snapshotFiles are copied into each snapshot (as stored: an encrypted journal stays encrypted)
+var snapshotFiles = []string{entriesFile, todosFile, archiveFile, trashFile, configFile, keyFile}
+
+// ErrSnapshotNotFound is returned when no snapshot has the given name
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Snapshot is one backup of the data files
+type Snapshot struct {
+	Name string    // Directory name under ~/.amos/backups, e.g. "2026-10-19T14-05-00"
+	Time time.Time // When it was taken
+}
+
+// SnapshotCounts is what a snapshot holds (for previews before restoring)
+type SnapshotCounts struct {
+	Entries  int
+	Todos    int
+	Archived int
+	Trashed  int
+}
+
+// ListSnapshots returns the snapshots, newest first
+func ListSnapshots() ([]Snapshot, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return nil, err
+	}
+	return listSnapshots(dir)
+}
+
+// CreateSnapshot backs up the data files now (e.g. before a risky change)
+// Returns the snapshot (zero if there was nothing to back up)
+func CreateSnapshot() (Snapshot, error) {
+	var snapshot Snapshot
+	err := withRawLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+		snapshot, err = takeSnapshot(dir, time.Now())
+		return err
+	})
+	return snapshot, err
+}
+
+// CountSnapshot counts the entries and todos in a snapshot
+// An encrypted snapshot needs the key it was taken with (the current one unless rekeyed since)
+func CountSnapshot(name string) (SnapshotCounts, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return SnapshotCounts{}, err
+	}
+	path, err := snapshotPath(dir, name)
+	if err != nil {
+		return SnapshotCounts{}, err
+	}
+
+	var counts SnapshotCounts
+	targets := []struct {
+		name  string
+		count *int
+	}{
+		{entriesFile, &counts.Entries},
+		{todosFile, &counts.Todos},
+		{archiveFile, &counts.Archived},
+		{trashFile, &counts.Trashed},
+	}
+	for _, target := range targets {
+		data, err := os.ReadFile(filepath.Join(path, target.name))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return counts, err
+		}
+		if data, err = decryptData(dir, data); err != nil {
+			return counts, fmt.Errorf("%s: %w", target.name, err)
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return counts, fmt.Errorf("%s: %w", target.name, err)
+		}
+		*target.count = len(items)
+	}
+	return counts, nil
+}
+
+// RestoreSnapshot replaces the data files with the ones in a snapshot
+// The current files are snapshotted first, so a restore can itself be undone
+func RestoreSnapshot(name string) error {
+	return withRawLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+		path, err := snapshotPath(dir, name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := takeSnapshot(dir, time.Now()); err != nil {
+			return fmt.Errorf("backing up current files: %w", err)
+		}
+
+		oldKey, _ := os.ReadFile(filepath.Join(dir, keyFile))
+		for _, file := range snapshotFiles {
+			data, err := os.ReadFile(filepath.Join(path, file))
+			if os.IsNotExist(err) {
+				// Not in the snapshot: it didn't exist yet
+				if err := os.Remove(filepath.Join(dir, file)); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				continue
+			} else if err != nil {
+				return err
+			}
+			if err := writeFileAtomic(filepath.Join(dir, file), data); err != nil {
+				return err
+			}
+		}
+
+		// Undo history describes changes to the files just replaced
+		if err := os.Remove(filepath.Join(dir, undoFile)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		// Taken under another passphrase (or before encryption was turned on): unlock again
+		if newKey, _ := os.ReadFile(filepath.Join(dir, keyFile)); !bytes.Equal(newKey, oldKey) && !keyOpens(dir) {
+			setKey(dir, nil)
+		}
+
+		return commitAsIfVersioned(dir, "amos: restore backup "+name)
+	})
+}
+
+// snapshotIfDue takes a snapshot when the newest one is older than snapshotEvery, then prunes old ones
+// Called with the storage lock held, before every write
+func snapshotIfDue(dir string) error {
+	snapshots, err := listSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if len(snapshots) > 0 && now.Sub(snapshots[0].Time) < snapshotEvery {
+		return nil
+	}
+	if _, err := takeSnapshot(dir, now); err != nil {
+		return err
+	}
+	return pruneSnapshots(dir, now)
+}
+
+// takeSnapshot copies the data files into backups/<time> (written to a temp directory,
+// then renamed, so a snapshot is never partial). Returns a zero Snapshot if there is no data yet.
+// A snapshot from the same second is reused when it holds the same files; otherwise the next free second is used
+func takeSnapshot(dir string, now time.Time) (Snapshot, error) {
+	snapshot := Snapshot{Name: now.Format(snapshotLayout), Time: now}
+	target := filepath.Join(dir, backupsDir, snapshot.Name)
+	for {
+		if _, err := os.Stat(target); os.IsNotExist(err) {
+			break
+		}
+		if snapshotMatches(dir, target) {
+			return snapshot, nil
+		}
+		now = now.Add(time.Second)
+		snapshot = Snapshot{Name: now.Format(snapshotLayout), Time: now}
+		target = filepath.Join(dir, backupsDir, snapshot.Name)
+	}
+
+	tmp := filepath.Join(dir, backupsDir, ".tmp-"+snapshot.Name)
+	if err := os.MkdirAll(tmp, 0700); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmp) // No-op after a successful rename
+
+	copied := 0
+	for _, file := range snapshotFiles {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return Snapshot{}, err
+		}
+		if err := os.WriteFile(filepath.Join(tmp, file), data, 0600); err != nil {
+			return Snapshot{}, err
+		}
+		copied++
+	}
+	if copied == 0 {
+		return Snapshot{}, nil
+	}
+
+	if err := os.Rename(tmp, target); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// resealSnapshots re-encrypts the snapshots taken with the old key file (oldParams, nil for plain text)
+// under the new one (params and key, nil to store plain text), so a rekey leaves no backup
+// in plain text or under the old passphrase. Snapshots under another key file are left alone.
+// Each snapshot is rebuilt in a temp directory and swapped in, so it is never partial
+func resealSnapshots(dir string, oldParams, oldKey, params, key []byte) error {
+	snapshots, err := listSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		path := filepath.Join(dir, backupsDir, snapshot.Name)
+		snapshotParams, err := os.ReadFile(filepath.Join(path, keyFile))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(snapshotParams, oldParams) {
+			continue
+		}
+
+		tmp := filepath.Join(dir, backupsDir, ".tmp-reseal-"+snapshot.Name)
+		if err := resealSnapshot(path, tmp, oldKey, params, key); err != nil {
+			os.RemoveAll(tmp)
+			return fmt.Errorf("backup %s: %w", snapshot.Name, err)
+		}
+		old := filepath.Join(dir, backupsDir, ".old-"+snapshot.Name)
+		if err := os.Rename(path, old); err != nil {
+			os.RemoveAll(tmp)
+			return err
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			os.Rename(old, path)
+			return err
+		}
+		os.RemoveAll(old)
+	}
+	return nil
+}
+
+// resealSnapshot writes the snapshot at path into tmp, decrypted with oldKey and sealed with key
+func resealSnapshot(path, tmp string, oldKey, params, key []byte) error {
+	if err := os.MkdirAll(tmp, 0700); err != nil {
+		return err
+	}
+	for _, file := range snapshotFiles {
+		if file == keyFile {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(path, file))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(data, cryptMagic) {
+			if oldKey == nil {
+				return ErrLocked
+			}
+			if data, err = openData(oldKey, data[len(cryptMagic):]); err != nil {
+				return fmt.Errorf("%s: can't decrypt with the old passphrase", file)
+			}
+		}
+		if key != nil && file != configFile {
+			if data, err = sealData(key, data); err != nil {
+				return err
+			}
+		}
+		if err := os.WriteFile(filepath.Join(tmp, file), data, 0600); err != nil {
+			return err
+		}
+	}
+	if params == nil {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(tmp, keyFile), params, 0600)
+}
+
+// snapshotMatches reports whether the snapshot at path holds exactly the current data files
+func snapshotMatches(dir, path string) bool {
+	for _, file := range snapshotFiles {
+		current, errCurrent := os.ReadFile(filepath.Join(dir, file))
+		saved, errSaved := os.ReadFile(filepath.Join(path, file))
+		if os.IsNotExist(errCurrent) && os.IsNotExist(errSaved) {
+			continue
+		}
+		if errCurrent != nil || errSaved != nil || !bytes.Equal(current, saved) {
+			return false
+		}
+	}
+	return true
+}
+
+// pruneSnapshots removes snapshots the retention rules don't keep
+func pruneSnapshots(dir string, now time.Time) error {
+	snapshots, err := listSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshotsToPrune(snapshots, now) {
+		if err := os.RemoveAll(filepath.Join(dir, backupsDir, snapshot.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotsToPrune returns the snapshots (newest first) retention doesn't keep: the newest one in each
+// of the last keepHourly hours, keepDaily days and keepWeekly ISO weeks stays; the newest overall always stays
+func snapshotsToPrune(snapshots []Snapshot, now time.Time) []Snapshot {
+	keptHours, keptDays, keptWeeks := map[string]bool{}, map[string]bool{}, map[string]bool{}
+	var prune []Snapshot
+
+	for i, snapshot := range snapshots {
+		t := snapshot.Time
+		year, week := t.ISOWeek()
+		hour := t.Format("2006-01-02T15")
+		day := t.Format("2006-01-02")
+		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+
+		keep := i == 0
+		if now.Sub(t) < keepHourly*time.Hour && !keptHours[hour] {
+			keep = true
+		}
+		if now.Sub(t) < keepDaily*24*time.Hour && !keptDays[day] {
+			keep = true
+		}
+		if now.Sub(t) < keepWeekly*7*24*time.Hour && !keptWeeks[weekKey] {
+			keep = true
+		}
+
+		if !keep {
+			prune = append(prune, snapshot)
+			continue
+		}
+		keptHours[hour], keptDays[day], keptWeeks[weekKey] = true, true, true
+	}
+	return prune
+}
+
+// listSnapshots returns the snapshots in dir, newest first (other files are ignored)
+func listSnapshots(dir string) ([]Snapshot, error) {
+	items, err := os.ReadDir(filepath.Join(dir, backupsDir))
+	if os.IsNotExist(err) {
+		return []Snapshot{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	snapshots := []Snapshot{}
+	for _, item := range items {
+		if !item.IsDir() {
+			continue
+		}
+		t, err := time.ParseInLocation(snapshotLayout, item.Name(), time.Local)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, Snapshot{Name: item.Name(), Time: t})
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Time.After(snapshots[j].Time) })
+	return snapshots, nil
+}
+
+// snapshotPath returns the directory of a snapshot, rejecting names that aren't snapshots
+func snapshotPath(dir, name string) (string, error) {
+	if _, err := time.Parse(snapshotLayout, name); err != nil || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("%w: %q", ErrSnapshotNotFound, name)
+	}
+	path := filepath.Join(dir, backupsDir, name)
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%w: %q", ErrSnapshotNotFound, name)
+	}
+	return path, nil
+}
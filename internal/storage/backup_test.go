@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestAutomaticSnapshotAndRestore(t *testing.T) {
+	useHome(t, t.TempDir())
+
+	// Nothing to back up before the first write
+	if err := SaveEntry(models.Entry{ID: "e1", Title: "First"}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if snapshots, _ := ListSnapshots(); len(snapshots) != 0 {
+		t.Fatalf("Expected no snapshot of an empty journal, got %v", snapshots)
+	}
+
+	// The next write backs up the journal as it was
+	if err := SaveTodo(models.Todo{ID: "t1", Title: "Call", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	snapshots, err := ListSnapshots()
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("ListSnapshots() = %v, %v; expected 1 snapshot", snapshots, err)
+	}
+	dir, _ := GetAmosDir()
+	if info, err := os.Stat(filepath.Join(dir, backupsDir, snapshots[0].Name, entriesFile)); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected a private copy of entries.json in the snapshot (%v)", err)
+	}
+
+	// Within the hour, writes don't snapshot again
+	if err := SaveEntry(models.Entry{ID: "e2", Title: "Second"}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if again, _ := ListSnapshots(); len(again) != 1 {
+		t.Errorf("Expected still 1 snapshot, got %d", len(again))
+	}
+
+	counts, err := CountSnapshot(snapshots[0].Name)
+	if err != nil {
+		t.Fatalf("CountSnapshot() failed: %v", err)
+	}
+	if counts != (SnapshotCounts{Entries: 1}) {
+		t.Errorf("CountSnapshot() = %+v, expected 1 entry and no todos", counts)
+	}
+
+	// Restoring brings back the old files, removing ones the snapshot didn't have
+	if err := RestoreSnapshot(snapshots[0].Name); err != nil {
+		t.Fatalf("RestoreSnapshot() failed: %v", err)
+	}
+	if entries, _ := LoadEntries(); len(entries) != 1 || entries[0].ID != "e1" {
+		t.Errorf("Expected only entry e1 after restore, got %+v", entries)
+	}
+	if todos, _ := LoadTodos(); len(todos) != 0 {
+		t.Errorf("Expected no todos after restore, got %+v", todos)
+	}
+
+	// ...after backing up the state it replaced
+	after, _ := ListSnapshots()
+	if len(after) != 2 {
+		t.Fatalf("Expected a snapshot of the replaced files, got %v", after)
+	}
+	if counts, _ := CountSnapshot(after[0].Name); counts != (SnapshotCounts{Entries: 2, Todos: 1}) {
+		t.Errorf("Pre-restore snapshot = %+v, expected 2 entries and 1 todo", counts)
+	}
+
+	if err := RestoreSnapshot("../entries.json"); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("RestoreSnapshot(bad name): expected ErrSnapshotNotFound, got %v", err)
+	}
+}
+
+func TestSnapshotsToPrune(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 30, 0, 0, time.Local)
+	at := func(ago time.Duration) Snapshot {
+		t := now.Add(-ago)
+		return Snapshot{Name: t.Format(snapshotLayout), Time: t}
+	}
+
+	snapshots := []Snapshot{
+		at(10 * time.Minute),              // Newest: kept
+		at(20 * time.Minute),              // Same hour as the newest: pruned
+		at(2 * time.Hour),                 // Hourly
+		at(30 * time.Hour),                // Daily (Oct 18)
+		at(31 * time.Hour),                // Same day: pruned
+		at(3 * 24 * time.Hour),            // Daily (Oct 16)
+		at(20 * 24 * time.Hour),           // Weekly
+		at(20*24*time.Hour + time.Minute), // Same week: pruned
+		at(70 * 24 * time.Hour),           // Older than keepWeekly: pruned
+	}
+
+	pruned := map[string]bool{}
+	for _, snapshot := range snapshotsToPrune(snapshots, now) {
+		pruned[snapshot.Name] = true
+	}
+	for i, snapshot := range snapshots {
+		want := i == 1 || i == 4 || i == 7 || i == 8
+		if pruned[snapshot.Name] != want {
+			t.Errorf("Snapshot %d (%s): pruned = %v, expected %v", i, snapshot.Name, pruned[snapshot.Name], want)
+		}
+	}
+
+	// The newest snapshot stays however old it is
+	if got := snapshotsToPrune([]Snapshot{at(365 * 24 * time.Hour)}, now); len(got) != 0 {
+		t.Errorf("Expected the only snapshot kept, got %v", got)
+	}
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+const configFile = "config.json"
+
+// LoadConfig loads user settings from config.json
+// Returns a zero Config if the file doesn't exist yet
+func LoadConfig() (models.Config, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return models.Config{}, err
+	}
+
+	path := filepath.Join(dir, configFile)
+
+	// If file doesn't exist, return defaults
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return models.Config{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.Config{}, err
+	}
+
+	var cfg models.Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return models.Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// SaveConfig saves user settings to config.json
+func SaveConfig(cfg models.Config) error {
+	return withLock(func() error { return saveConfig(cfg) })
+}
+
+// saveConfig is SaveConfig without taking the storage lock
+func saveConfig(cfg models.Config) error {
+	if err := EnsureAmosDir(); err != nil {
+		return err
+	}
+
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, configFile)
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, data)
+}
+
+// SaveTagAlias records alias -> canonical in the alias table
+// Existing aliases that pointed at the alias are re-pointed at the canonical tag
+func SaveTagAlias(alias, canonical string) error {
+	return withLock(func() error { return saveTagAlias(alias, canonical) })
+}
+
+// saveTagAlias is SaveTagAlias without taking the storage lock
+func saveTagAlias(alias, canonical string) error {
+	cfg, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.TagAliases == nil {
+		cfg.TagAliases = make(map[string]string)
+	}
+
+	for from, to := range cfg.TagAliases {
+		if to == alias {
+			cfg.TagAliases[from] = canonical
+		}
+	}
+	// A canonical tag must never alias itself away
+	delete(cfg.TagAliases, canonical)
+	cfg.TagAliases[alias] = canonical
+
+	return saveConfig(cfg)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+
+	if len(cfg.TagAliases) != 0 {
+		t.Errorf("LoadConfig() TagAliases = %v, want empty", cfg.TagAliases)
+	}
+}
+
+func TestSaveAndLoadConfig(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	cfg := models.Config{TagAliases: map[string]string{"dev": "development"}}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig() failed: %v", err)
+	}
+
+	loaded, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+
+	if loaded.TagAliases["dev"] != "development" {
+		t.Errorf("LoadConfig() TagAliases[dev] = %q, want 'development'", loaded.TagAliases["dev"])
+	}
+}
+
+func TestSaveTagAliasRepointsChains(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	// dev -> development, then development -> engineering
+	if err := SaveTagAlias("dev", "development"); err != nil {
+		t.Fatalf("SaveTagAlias() failed: %v", err)
+	}
+	if err := SaveTagAlias("development", "engineering"); err != nil {
+		t.Fatalf("SaveTagAlias() failed: %v", err)
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+
+	if cfg.TagAliases["dev"] != "engineering" {
+		t.Errorf("TagAliases[dev] = %q, want 'engineering'", cfg.TagAliases["dev"])
+	}
+	if cfg.TagAliases["development"] != "engineering" {
+		t.Errorf("TagAliases[development] = %q, want 'engineering'", cfg.TagAliases["development"])
+	}
+
+	// Renaming back must not leave a self-referencing alias
+	if err := SaveTagAlias("engineering", "dev"); err != nil {
+		t.Fatalf("SaveTagAlias() failed: %v", err)
+	}
+	cfg, _ = LoadConfig()
+	if _, exists := cfg.TagAliases["dev"]; exists {
+		t.Errorf("TagAliases still aliases canonical tag 'dev': %v", cfg.TagAliases)
+	}
+}
@@ -0,0 +1,347 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// keyFile holds the salt and KDF parameters of an encrypted journal (never the key itself)
+// Its presence means the data files are encrypted; it is versioned with them so every
+// machine derives the same key from the passphrase
+const keyFile = "encryption.json"
+
+// cryptMagic starts every encrypted data file, followed by the GCM nonce and the ciphertext
+var cryptMagic = []byte("AMOSENC1")
+
+// cryptCheck is encrypted into encryption.json so a wrong passphrase is caught on unlock,
+// not by a failing load
+var cryptCheck = []byte("amos")
+
+// encryptedFiles are the files encrypted at rest (settings and exports stay plain text;
+// a versioned encrypted journal keeps exports out of git, see gitIgnoredEncrypted)
+var encryptedFiles = []string{entriesFile, todosFile, archiveFile, trashFile, undoFile}
+
+var (
+	// ErrLocked is returned when reading or writing an encrypted journal before Unlock
+	ErrLocked = errors.New("journal is encrypted: passphrase required")
+	// ErrWrongPassphrase is returned by Unlock when the passphrase doesn't match
+	ErrWrongPassphrase = errors.New("wrong passphrase")
+)
+
+// keyParams is the content of encryption.json
+type keyParams struct {
+	KDF     string `json:"kdf"`     // Always "argon2id"
+	Salt    []byte `json:"salt"`    // Random, 16 bytes
+	Time    uint32 `json:"time"`    // Argon2 passes
+	Memory  uint32 `json:"memory"`  // Argon2 memory in KiB
+	Threads uint8  `json:"threads"` // Argon2 parallelism
+	Check   []byte `json:"check"`   // cryptCheck sealed with the key
+}
+
+// unlocked is the key derived by Unlock, for the data directory it was derived for
+var unlocked struct {
+	sync.Mutex
+	dir string
+	key []byte
+}
+
+// IsEncrypted reports whether the data files are encrypted
+func IsEncrypted() bool {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filepath.Join(dir, keyFile))
+	return err == nil
+}
+
+// Unlock derives the key from passphrase so encrypted data files can be read and written
+// Returns ErrWrongPassphrase if it doesn't match (a plain-text journal needs no unlock)
+func Unlock(passphrase string) error {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+	params, err := loadKeyParams(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	key := deriveKey(passphrase, params)
+	if check, err := openData(key, params.Check); err != nil || !bytes.Equal(check, cryptCheck) {
+		return ErrWrongPassphrase
+	}
+
+	setKey(dir, key)
+	return nil
+}
+
+// Rekey re-encrypts every data file with a key derived from passphrase
+// On a plain-text journal this turns encryption on; an empty passphrase turns it off
+// An encrypted journal must be unlocked first
+// A snapshot is taken first and encryption.json is replaced last: if a write fails, the files are put back,
+// and a crash in between leaves a backup that still opens with the old key file
+// Once the new key is in place, backups taken with the old key file (or in plain text) are re-encrypted with it
+func Rekey(passphrase string) error {
+	// Old and new versions can't be decrypted with the same key, so the commit isn't described from a diff
+	return withRawLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+
+		// Read everything with the current key before switching (and keep the stored bytes to roll back)
+		contents, stored := map[string][]byte{}, map[string][]byte{}
+		for _, name := range encryptedFiles {
+			path := filepath.Join(dir, name)
+			data, err := readDataFile(path)
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			if stored[name], err = os.ReadFile(path); err != nil {
+				return err
+			}
+			contents[name] = data
+		}
+
+		// Pending changes are committed (and described) with the current key
+		if isVersioned(dir) {
+			if err := commitWorkingTree(dir); err != nil {
+				return err
+			}
+		}
+		if _, err := takeSnapshot(dir, time.Now()); err != nil {
+			return fmt.Errorf("backing up before rekey: %w", err)
+		}
+
+		keyPath := filepath.Join(dir, keyFile)
+		oldParams, err := os.ReadFile(keyPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		oldKey := keyFor(dir)
+		message := "amos: change passphrase"
+		var key, params []byte
+		if passphrase == "" {
+			message = "amos: turn off encryption"
+		} else {
+			if _, err := os.Stat(keyPath); os.IsNotExist(err) {
+				message = "amos: turn on encryption"
+			}
+			newParams, newKey, err := newKeyParams(passphrase)
+			if err != nil {
+				return err
+			}
+			if params, err = json.MarshalIndent(newParams, "", "  "); err != nil {
+				return err
+			}
+			key = newKey
+		}
+
+		// Seal everything before touching the disk
+		sealed := map[string][]byte{}
+		for name, data := range contents {
+			if key == nil {
+				sealed[name] = data
+			} else if sealed[name], err = sealData(key, data); err != nil {
+				return err
+			}
+		}
+
+		// Data files first, the key file last; put everything back if any write fails
+		rollback := func(cause error) error {
+			for name, data := range stored {
+				_ = writeFileAtomic(filepath.Join(dir, name), data)
+			}
+			return cause
+		}
+		for name, data := range sealed {
+			if err := writeFileAtomic(filepath.Join(dir, name), data); err != nil {
+				return rollback(fmt.Errorf("rewriting %s: %w", name, err))
+			}
+		}
+		if key == nil {
+			err = os.Remove(keyPath)
+			if os.IsNotExist(err) {
+				err = nil
+			}
+		} else {
+			err = writeFileAtomic(keyPath, params)
+		}
+		if err != nil {
+			return rollback(fmt.Errorf("writing %s: %w", keyFile, err))
+		}
+		setKey(dir, key)
+
+		if err := commitAsIfVersioned(dir, message); err != nil {
+			return err
+		}
+		// Backups follow the new key: none left in plain text or under the old passphrase
+		if err := resealSnapshots(dir, oldParams, oldKey, params, key); err != nil {
+			return fmt.Errorf("passphrase changed, but re-encrypting backups failed: %w", err)
+		}
+		return nil
+	})
+}
+
+// readDataFile reads a data file, decrypting it if it is encrypted
+// A plain-text file is returned as is (e.g. restored by hand into an encrypted journal)
+func readDataFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decryptData(filepath.Dir(path), data)
+}
+
+// writeDataFile writes a data file, encrypted when the journal is
+func writeDataFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if _, err := os.Stat(filepath.Join(dir, keyFile)); err == nil {
+		key := keyFor(dir)
+		if key == nil {
+			// Never write plain text over an encrypted journal
+			return ErrLocked
+		}
+		sealed, err := sealData(key, data)
+		if err != nil {
+			return err
+		}
+		data = sealed
+	}
+	return writeFileAtomic(path, data)
+}
+
+// decryptData decrypts the content of a data file from dir (plain text is returned as is)
+func decryptData(dir string, data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, cryptMagic) {
+		return data, nil
+	}
+	key := keyFor(dir)
+	if key == nil {
+		return nil, ErrLocked
+	}
+	plain, err := openData(key, data[len(cryptMagic):])
+	if err != nil {
+		return nil, errors.New("can't decrypt data file (encrypted with another passphrase?)")
+	}
+	return plain, nil
+}
+
+// sealData encrypts data as magic + nonce + AES-256-GCM ciphertext
+func sealData(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append(append([]byte{}, cryptMagic...), nonce...)
+	return gcm.Seal(out, nonce, data, nil), nil
+}
+
+// openData decrypts nonce + ciphertext (sealData output without the magic)
+func openData(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted data too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// newGCM returns AES-256-GCM for key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// newKeyParams picks a fresh salt and derives a key from passphrase with it
+func newKeyParams(passphrase string) (keyParams, []byte, error) {
+	params := keyParams{KDF: "argon2id", Salt: make([]byte, 16), Time: 3, Memory: 64 * 1024, Threads: 4}
+	if _, err := rand.Read(params.Salt); err != nil {
+		return keyParams{}, nil, err
+	}
+	key := deriveKey(passphrase, params)
+
+	// Sealed without the magic: the check isn't a data file
+	check, err := sealData(key, cryptCheck)
+	if err != nil {
+		return keyParams{}, nil, err
+	}
+	params.Check = check[len(cryptMagic):]
+	return params, key, nil
+}
+
+// deriveKey derives the AES-256 key from passphrase (Argon2id)
+func deriveKey(passphrase string, params keyParams) []byte {
+	return argon2.IDKey([]byte(passphrase), params.Salt, params.Time, params.Memory, params.Threads, 32)
+}
+
+// loadKeyParams reads encryption.json from dir
+func loadKeyParams(dir string) (keyParams, error) {
+	data, err := os.ReadFile(filepath.Join(dir, keyFile))
+	if err != nil {
+		return keyParams{}, err
+	}
+	var params keyParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return keyParams{}, fmt.Errorf("%s: %w", keyFile, err)
+	}
+	if params.KDF != "argon2id" {
+		return keyParams{}, fmt.Errorf("%s: unsupported kdf %q", keyFile, params.KDF)
+	}
+	return params, nil
+}
+
+// keyOpens reports whether the key unlocked for dir matches encryption.json
+// (false when no key is unlocked or the journal isn't encrypted)
+func keyOpens(dir string) bool {
+	key := keyFor(dir)
+	params, err := loadKeyParams(dir)
+	if key == nil || err != nil {
+		return false
+	}
+	check, err := openData(key, params.Check)
+	return err == nil && bytes.Equal(check, cryptCheck)
+}
+
+// setKey remembers the key for dir (nil forgets it)
+func setKey(dir string, key []byte) {
+	unlocked.Lock()
+	defer unlocked.Unlock()
+	unlocked.dir, unlocked.key = dir, key
+}
+
+// keyFor returns the key unlocked for dir (nil if none)
+func keyFor(dir string) []byte {
+	unlocked.Lock()
+	defer unlocked.Unlock()
+	if unlocked.dir != dir {
+		return nil
+	}
+	return unlocked.key
+}
@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	useHome(t, t.TempDir())
+	t.Cleanup(func() { setKey("", nil) })
+	dir, _ := GetAmosDir()
+	path := filepath.Join(dir, entriesFile)
+
+	if err := SaveEntry(models.Entry{ID: "e1", Title: "Client notes", Body: "Confidential"}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() failed: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected entries.json with mode 0600, got %v", info.Mode().Perm())
+	}
+	if IsEncrypted() {
+		t.Fatal("Expected a new journal to be plain text")
+	}
+
+	if err := Rekey("correct horse"); err != nil {
+		t.Fatalf("Rekey() failed: %v", err)
+	}
+	if !IsEncrypted() {
+		t.Fatal("Expected the journal to be encrypted")
+	}
+	data, _ := os.ReadFile(path)
+	if !bytes.HasPrefix(data, cryptMagic) || bytes.Contains(data, []byte("Confidential")) {
+		t.Fatalf("Expected entries.json to be encrypted, got %q", data)
+	}
+	if entries, err := LoadEntries(); err != nil || len(entries) != 1 || entries[0].Body != "Confidential" {
+		t.Fatalf("LoadEntries() after Rekey = %+v, %v", entries, err)
+	}
+
+	// A new process starts locked
+	setKey("", nil)
+	if _, err := LoadEntries(); !errors.Is(err, ErrLocked) {
+		t.Errorf("LoadEntries() while locked: expected ErrLocked, got %v", err)
+	}
+	if err := SaveTodo(models.Todo{ID: "t1", Title: "Leak", Status: "open", CreatedAt: time.Now()}); !errors.Is(err, ErrLocked) {
+		t.Errorf("SaveTodo() while locked: expected ErrLocked, got %v", err)
+	}
+	if err := Unlock("wrong"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Unlock(wrong): expected ErrWrongPassphrase, got %v", err)
+	}
+	if err := Unlock("correct horse"); err != nil {
+		t.Fatalf("Unlock() failed: %v", err)
+	}
+
+	// New files are encrypted too
+	if err := SaveTodo(models.Todo{ID: "t1", Title: "Call client", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, todosFile)); !bytes.HasPrefix(data, cryptMagic) {
+		t.Errorf("Expected todos.json to be encrypted, got %q", data)
+	}
+
+	// Changing the passphrase: the old one stops working
+	if err := Rekey("battery staple"); err != nil {
+		t.Fatalf("Rekey() failed: %v", err)
+	}
+	setKey("", nil)
+	if err := Unlock("correct horse"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Unlock(old passphrase): expected ErrWrongPassphrase, got %v", err)
+	}
+	if err := Unlock("battery staple"); err != nil {
+		t.Fatalf("Unlock() failed: %v", err)
+	}
+
+	// Turning encryption off writes plain JSON again
+	if err := Rekey(""); err != nil {
+		t.Fatalf("Rekey(\"\") failed: %v", err)
+	}
+	if IsEncrypted() {
+		t.Error("Expected encryption to be off")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, todosFile)); !bytes.Contains(data, []byte("Call client")) {
+		t.Errorf("Expected plain-text todos.json, got %q", data)
+	}
+}
+
+func TestRekeyResealsSnapshots(t *testing.T) {
+	useHome(t, t.TempDir())
+	t.Cleanup(func() { setKey("", nil) })
+	dir, _ := GetAmosDir()
+
+	if err := SaveEntry(models.Entry{ID: "e1", Title: "Client notes", Body: "Confidential"}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if _, err := CreateSnapshot(); err != nil {
+		t.Fatalf("CreateSnapshot() failed: %v", err)
+	}
+	// Taken under some other passphrase: left alone
+	foreign := filepath.Join(dir, backupsDir, "2026-01-01T00-00-00")
+	os.MkdirAll(foreign, 0700)
+	os.WriteFile(filepath.Join(foreign, keyFile), []byte(`{"kdf": "argon2id"}`), 0600)
+	os.WriteFile(filepath.Join(foreign, entriesFile), []byte("AMOSENC1 sealed elsewhere"), 0600)
+
+	for _, passphrase := range []string{"correct horse", "battery staple", ""} {
+		if err := Rekey(passphrase); err != nil {
+			t.Fatalf("Rekey(%q) failed: %v", passphrase, err)
+		}
+		snapshots, _ := ListSnapshots()
+		for _, snapshot := range snapshots {
+			path := filepath.Join(dir, backupsDir, snapshot.Name)
+			data, _ := os.ReadFile(filepath.Join(path, entriesFile))
+			if snapshot.Name == "2026-01-01T00-00-00" {
+				if string(data) != "AMOSENC1 sealed elsewhere" {
+					t.Errorf("Foreign snapshot changed: %q", data)
+				}
+				continue
+			}
+			if encrypted := bytes.HasPrefix(data, cryptMagic); encrypted != (passphrase != "") || (encrypted && bytes.Contains(data, []byte("Confidential"))) {
+				t.Errorf("After Rekey(%q), %s holds %q", passphrase, snapshot.Name, data)
+			}
+			if counts, err := CountSnapshot(snapshot.Name); err != nil || counts.Entries != 1 {
+				t.Errorf("After Rekey(%q), CountSnapshot(%s) = %+v, %v", passphrase, snapshot.Name, counts, err)
+			}
+		}
+	}
+}
+
+func TestEncryptedVersioning(t *testing.T) {
+	requireGit(t)
+	useHome(t, t.TempDir())
+	t.Cleanup(func() { setKey("", nil) })
+
+	if err := SaveTodo(models.Todo{ID: "t1", Title: "Fix build", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	if err := InitVersioning(); err != nil {
+		t.Fatalf("InitVersioning() failed: %v", err)
+	}
+	if err := Rekey("secret"); err != nil {
+		t.Fatalf("Rekey() failed: %v", err)
+	}
+	if got := lastCommit(t); got != "amos: turn on encryption" {
+		t.Errorf("Rekey commit = %q", got)
+	}
+
+	// Commit messages are described from the decrypted data, but without titles (they are pushed in plain text)
+	if _, err := UpdateTodo("t1", func(todo *models.Todo) error { todo.Status = "done"; return nil }); err != nil {
+		t.Fatalf("UpdateTodo() failed: %v", err)
+	}
+	if got := lastCommit(t); got != "todo: set done 1" {
+		t.Errorf("Commit after encryption = %q", got)
+	}
+}
+
+func TestEncryptedVersioningKeepsExportsOut(t *testing.T) {
+	requireGit(t)
+	useHome(t, t.TempDir())
+	t.Cleanup(func() { setKey("", nil) })
+	dir, _ := GetAmosDir()
+
+	if err := SaveTodo(models.Todo{ID: "t1", Title: "Fix build", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	if err := InitVersioning(); err != nil {
+		t.Fatalf("InitVersioning() failed: %v", err)
+	}
+	// Exported before encryption: versioned like any file
+	if _, err := SaveReview("2026-W41", "# Old notes"); err != nil {
+		t.Fatalf("SaveReview() failed: %v", err)
+	}
+	if err := Rekey("secret"); err != nil {
+		t.Fatalf("Rekey() failed: %v", err)
+	}
+
+	if _, err := SaveReview("2026-W42", "# Secret client notes"); err != nil {
+		t.Fatalf("SaveReview() failed: %v", err)
+	}
+	if _, err := SaveTimesheet("2026-W42", "tag,hours\nclient,3\n"); err != nil {
+		t.Fatalf("SaveTimesheet() failed: %v", err)
+	}
+	if err := SaveTodo(models.Todo{ID: "t2", Title: "Call client", Status: "open", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+
+	tracked, err := runGit(dir, "ls-files")
+	if err != nil {
+		t.Fatalf("git ls-files failed: %v", err)
+	}
+	if strings.Contains(tracked, reviewsDir+"/") || strings.Contains(tracked, timesheetsDir+"/") {
+		t.Errorf("Expected no exports tracked in an encrypted journal, got:\n%s", tracked)
+	}
+	if _, err := os.Stat(filepath.Join(dir, reviewsDir, "2026-W41.md")); err != nil {
+		t.Errorf("Expected the earlier export to stay on disk: %v", err)
+	}
+	if status, _ := runGit(dir, "status", "--porcelain"); status != "" {
+		t.Errorf("Expected a clean working tree, got %q", status)
+	}
+}
@@ -0,0 +1,524 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+)
+
+const (
+	gitDir       = ".git"       // Present when the data directory is versioned ("amos sync --init")
+	gitIgnore    = ".gitignore" // Keeps machine-local files out of the history
+	gitMaxChange = 40           // Changes listed in a commit body before "..."
+)
+
+// gitIgnored are files that belong to this machine only
+var gitIgnored = []string{lockFile, tokenFile, undoFile, ".*.tmp*", backupsDir + "/"}
+
+// gitIgnoredEncrypted are also kept out of the history once the journal is encrypted:
+// exports are written in plain text, and the history is pushed by amos sync
+var gitIgnoredEncrypted = []string{reviewsDir + "/", timesheetsDir + "/"}
+
+// todoFiles are the files a todo can live in
+var todoFiles = []string{todosFile, archiveFile, trashFile}
+
+// ErrNotVersioned is returned by Sync when the data directory isn't a git repository
+var ErrNotVersioned = errors.New("data directory is not versioned (run amos sync --init)")
+
+// locatedTodo is a todo with the file it lives in (todos.json, archive.json or trash.json)
+type locatedTodo struct {
+	File string      `json:"file"`
+	Todo models.Todo `json:"todo"`
+}
+
+// dataState is the journal as of one commit (or the working tree)
+type dataState struct {
+	entries []models.Entry
+	todos   []locatedTodo
+	config  []byte // Raw config.json (nil if missing)
+}
+
+// IsVersioned reports whether the data directory is a git repository
+func IsVersioned() bool {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return false
+	}
+	return isVersioned(dir)
+}
+
+// isVersioned reports whether dir is a git repository
+func isVersioned(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, gitDir))
+	return err == nil && info.IsDir()
+}
+
+// InitVersioning makes the data directory a git repository and commits the current data
+// Running it again only commits pending changes
+func InitVersioning() error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return errors.New("git not found in PATH")
+	}
+
+	return withRawLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+
+		if !isVersioned(dir) {
+			if _, err := runGit(dir, "init", "-q"); err != nil {
+				return err
+			}
+			if _, err := runGit(dir, "symbolic-ref", "HEAD", "refs/heads/"+syncBranch); err != nil {
+				return err
+			}
+		}
+
+		return commitWorkingTree(dir)
+	})
+}
+
+// ensureGitIgnore adds the gitIgnored patterns missing from .gitignore
+// (directories versioned by an older amos lack the newer ones; patterns added by hand stay)
+// An encrypted journal also ignores gitIgnoredEncrypted, and exports committed before are untracked
+func ensureGitIgnore(dir string) error {
+	patterns := gitIgnored
+	_, err := os.Stat(filepath.Join(dir, keyFile))
+	encrypted := err == nil
+	if encrypted {
+		patterns = append(append([]string{}, gitIgnored...), gitIgnoredEncrypted...)
+	}
+
+	ignorePath := filepath.Join(dir, gitIgnore)
+	data, err := os.ReadFile(ignorePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	present := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+	content := string(data)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	missing := false
+	for _, pattern := range patterns {
+		if !present[pattern] {
+			content += pattern + "\n"
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+	if err := writeFileAtomic(ignorePath, []byte(content)); err != nil {
+		return err
+	}
+	if !encrypted {
+		return nil
+	}
+	// Ignoring doesn't untrack: drop them from the index (the files stay on disk)
+	_, err = runGit(dir, "rm", "-r", "-q", "--cached", "--ignore-unmatch", "--", reviewsDir, timesheetsDir)
+	return err
+}
+
+// commitIfVersioned commits pending changes when the data directory is versioned
+// Called with the storage lock held, after every locked write
+func commitIfVersioned() error {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+	if !isVersioned(dir) {
+		return nil
+	}
+	if err := commitWorkingTree(dir); err != nil {
+		return fmt.Errorf("saved, but the git commit failed: %w", err)
+	}
+	return nil
+}
+
+// commitAsIfVersioned commits every change in dir with the given message when dir is versioned
+// For rewrites a diff can't describe (e.g. re-encrypting every file)
+func commitAsIfVersioned(dir, message string) error {
+	if !isVersioned(dir) {
+		return nil
+	}
+	if err := ensureGitIgnore(dir); err != nil {
+		return err
+	}
+	if status, err := runGit(dir, "status", "--porcelain"); err != nil || status == "" {
+		return err
+	}
+	if _, err := runGit(dir, "add", "-A"); err != nil {
+		return err
+	}
+	if err := gitCommit(dir, message); err != nil {
+		return fmt.Errorf("saved, but the git commit failed: %w", err)
+	}
+	return nil
+}
+
+// commitWorkingTree commits every change in dir with a message describing it
+func commitWorkingTree(dir string) error {
+	if err := ensureGitIgnore(dir); err != nil {
+		return err
+	}
+	status, err := runGit(dir, "status", "--porcelain", "-uall")
+	if err != nil {
+		return err
+	}
+	if status == "" {
+		return nil
+	}
+
+	after, err := loadStateAt(dir, "")
+	if err != nil {
+		return err
+	}
+
+	var message string
+	if _, err := runGit(dir, "rev-parse", "-q", "--verify", "HEAD"); err != nil {
+		// First commit: listing every entry would be noise
+		message = fmt.Sprintf("amos: start versioning (%d entries, %d todos)", len(after.entries), len(after.todos))
+	} else {
+		before, err := loadStateAt(dir, "HEAD")
+		if err != nil {
+			return err
+		}
+		// An encrypted journal keeps titles out of commit messages (they are pushed in plain text)
+		_, err = os.Stat(filepath.Join(dir, keyFile))
+		message = describeChanges(before, after, statusPaths(status), err == nil)
+	}
+
+	if _, err := runGit(dir, "add", "-A"); err != nil {
+		return err
+	}
+	return gitCommit(dir, message)
+}
+
+// gitCommit commits the index
+func gitCommit(dir, message string) error {
+	_, err := runGitAsUser(dir, "commit", "-q", "--no-verify", "-m", message)
+	return err
+}
+
+// runGitAsUser runs a git command that records an author (commit, merge),
+// falling back to an "amos" identity when the user has none configured
+func runGitAsUser(dir string, args ...string) (string, error) {
+	if email, _ := runGit(dir, "config", "user.email"); email == "" {
+		args = append([]string{"-c", "user.name=amos", "-c", "user.email=amos@localhost"}, args...)
+	}
+	return runGit(dir, args...)
+}
+
+// runGit runs git in dir and returns its trimmed output (stderr is included in errors)
+func runGit(dir string, args ...string) (string, error) {
+	out, err := runGitRaw(dir, args...)
+	return strings.TrimSpace(string(out)), err
+}
+
+// runGitRaw runs git in dir and returns its output untouched (e.g. encrypted file contents)
+func runGitRaw(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		// Name the subcommand in errors, not the -c options before it
+		name := args[0]
+		for i := 0; i+2 < len(args) && args[i] == "-c"; i += 2 {
+			name = args[i+2]
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", name, msg)
+		}
+		return nil, fmt.Errorf("git %s: %w", name, err)
+	}
+	return out, nil
+}
+
+// statusPaths returns the paths listed by "git status --porcelain"
+// (lines are "XY path"; runGit trims the leading space of the first one)
+func statusPaths(status string) []string {
+	var paths []string
+	for _, line := range strings.Split(status, "\n") {
+		_, path, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			continue
+		}
+		path = strings.TrimSpace(path)
+		if _, renamed, ok := strings.Cut(path, " -> "); ok {
+			path = renamed
+		}
+		paths = append(paths, strings.Trim(path, `"`))
+	}
+	return paths
+}
+
+// loadStateAt reads entries, todos and config as of a git revision ("" = the working tree)
+// Missing files are empty; encrypted files are decrypted with the unlocked key
+func loadStateAt(dir, rev string) (dataState, error) {
+	read := func(name string) ([]byte, error) {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return decryptData(dir, data)
+	}
+
+	if rev != "" {
+		// Only ask git for files that exist in the revision
+		listing, err := runGit(dir, "ls-tree", "--name-only", rev)
+		if err != nil {
+			return dataState{}, err
+		}
+		inTree := map[string]bool{}
+		for _, name := range strings.Split(listing, "\n") {
+			inTree[name] = true
+		}
+		read = func(name string) ([]byte, error) {
+			if !inTree[name] {
+				return nil, nil
+			}
+			data, err := runGitRaw(dir, "show", rev+":"+name)
+			if err != nil {
+				return nil, err
+			}
+			return decryptData(dir, data)
+		}
+	}
+
+	var state dataState
+	data, err := read(entriesFile)
+	if err != nil {
+		return state, err
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &state.entries); err != nil {
+			return state, fmt.Errorf("%s: %w", entriesFile, err)
+		}
+	}
+
+	for _, name := range todoFiles {
+		data, err := read(name)
+		if err != nil {
+			return state, err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		var todos []models.Todo
+		if err := json.Unmarshal(data, &todos); err != nil {
+			return state, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, todo := range todos {
+			state.todos = append(state.todos, locatedTodo{File: name, Todo: todo})
+		}
+	}
+
+	state.config, err = read(configFile)
+	return state, err
+}
+
+// dataChange is one change between two states, for commit messages
+type dataChange struct {
+	kind   string // "entry", "todo", "config" or a path
+	action string // "add", "edit", "set done", ...
+	id     string // Entry or todo ID ("" for files)
+	text   string // Full description, with the title ("todo: Fix build → done")
+}
+
+// describeChanges summarizes the difference between two states as a commit message
+// The subject is the first change ("todo: Fix build → done") plus how many more; the body lists them all
+// With redact (an encrypted journal) titles stay out of the history: only counts and IDs ("entry: edit 1")
+// Private entries (helpers.IsPrivateEntry) and their todos never show titles ("entry: edit (private)")
+func describeChanges(before, after dataState, paths []string, redact bool) string {
+	var changes []dataChange
+
+	// An entry private on either side keeps its title (and its todos' titles) out
+	private := map[string]bool{}
+	for _, entry := range append(append([]models.Entry{}, before.entries...), after.entries...) {
+		if helpers.IsPrivateEntry(entry) {
+			private[entry.ID] = true
+		}
+	}
+	privateTodos := map[string]bool{}
+	for _, located := range append(append([]locatedTodo{}, before.todos...), after.todos...) {
+		if located.Todo.EntryID != nil && private[*located.Todo.EntryID] {
+			privateTodos[located.Todo.ID] = true
+		}
+	}
+
+	oldEntries := map[string]models.Entry{}
+	for _, entry := range before.entries {
+		oldEntries[entry.ID] = entry
+	}
+	seenEntries := map[string]bool{}
+	for _, entry := range after.entries {
+		seenEntries[entry.ID] = true
+		old, existed := oldEntries[entry.ID]
+		switch {
+		case !existed:
+			changes = append(changes, dataChange{"entry", "add", entry.ID, "entry: add " + shortTitle(entry.Title)})
+		case !jsonEqual(old, entry):
+			changes = append(changes, dataChange{"entry", "edit", entry.ID, "entry: edit " + shortTitle(entry.Title)})
+		}
+	}
+	for _, entry := range before.entries {
+		if !seenEntries[entry.ID] {
+			changes = append(changes, dataChange{"entry", "remove", entry.ID, "entry: remove " + shortTitle(entry.Title)})
+		}
+	}
+
+	oldTodos := map[string]locatedTodo{}
+	for _, located := range before.todos {
+		oldTodos[located.Todo.ID] = located
+	}
+	seenTodos := map[string]bool{}
+	for _, located := range after.todos {
+		seenTodos[located.Todo.ID] = true
+		old, existed := oldTodos[located.Todo.ID]
+		if !existed {
+			changes = append(changes, dataChange{"todo", "add", located.Todo.ID, "todo: add " + shortTitle(located.Todo.Title)})
+		} else if action, text := describeTodoChange(old, located); action != "" {
+			changes = append(changes, dataChange{"todo", action, located.Todo.ID, text})
+		}
+	}
+	for _, located := range before.todos {
+		if !seenTodos[located.Todo.ID] {
+			changes = append(changes, dataChange{"todo", "purge", located.Todo.ID, "todo: purge " + shortTitle(located.Todo.Title)})
+		}
+	}
+
+	// Everything else that changed (settings, exports)
+	for _, path := range paths {
+		switch {
+		case path == entriesFile || path == todosFile || path == archiveFile || path == trashFile:
+		case path == configFile:
+			if !bytes.Equal(bytes.TrimSpace(before.config), bytes.TrimSpace(after.config)) {
+				changes = append(changes, dataChange{"config", "update settings", "", "config: update settings"})
+			}
+		default:
+			changes = append(changes, dataChange{path, "update", "", "update " + path})
+		}
+	}
+
+	if len(changes) == 0 {
+		return "amos: update data"
+	}
+	if redact {
+		return describeRedacted(changes)
+	}
+	for i, change := range changes {
+		if (change.kind == "entry" && private[change.id]) || (change.kind == "todo" && privateTodos[change.id]) {
+			changes[i].text = change.kind + ": " + change.action + " (private)"
+		}
+	}
+	if len(changes) == 1 {
+		return changes[0].text
+	}
+
+	subject := fmt.Sprintf("%s (+%d more)", changes[0].text, len(changes)-1)
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, change.text)
+	}
+	if len(lines) > gitMaxChange {
+		lines = append(lines[:gitMaxChange], "...")
+	}
+	return subject + "\n\n" + strings.Join(lines, "\n")
+}
+
+// describeRedacted counts changes by kind and action ("entry: edit 1, todo: add 2"), listing IDs in the body
+func describeRedacted(changes []dataChange) string {
+	var groups []string
+	counts := map[string]int{}
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		group := change.kind + ": " + change.action
+		if counts[group] == 0 {
+			groups = append(groups, group)
+		}
+		counts[group]++
+		if change.id != "" {
+			lines = append(lines, group+" "+change.id)
+		}
+	}
+
+	parts := make([]string, 0, len(groups))
+	for _, group := range groups {
+		parts = append(parts, fmt.Sprintf("%s %d", group, counts[group]))
+	}
+	subject := strings.Join(parts, ", ")
+	if len(lines) == 0 {
+		return subject
+	}
+	if len(lines) > gitMaxChange {
+		lines = append(lines[:gitMaxChange], "...")
+	}
+	return subject + "\n\n" + strings.Join(lines, "\n")
+}
+
+// describeTodoChange describes how one todo changed: a title-free action and the full text ("" if it didn't)
+func describeTodoChange(before, after locatedTodo) (action, text string) {
+	title := shortTitle(after.Todo.Title)
+
+	if before.File != after.File {
+		switch after.File {
+		case archiveFile:
+			return "archive", "todo: archive " + title
+		case trashFile:
+			return "delete", "todo: delete " + title
+		default:
+			return "restore", "todo: restore " + title
+		}
+	}
+
+	old, todo := before.Todo, after.Todo
+	switch {
+	case jsonEqual(old, todo):
+		return "", ""
+	case old.Status != todo.Status:
+		return "set " + todo.Status, "todo: " + title + " → " + todo.Status
+	case old.Title != todo.Title:
+		return "rename", "todo: rename " + shortTitle(old.Title) + " → " + title
+	case helpers.TimerRunning(todo) && !helpers.TimerRunning(old):
+		return "start timer", "todo: start timer on " + title
+	case !helpers.TimerRunning(todo) && helpers.TimerRunning(old):
+		return "stop timer", "todo: stop timer on " + title
+	case len(todo.Pomodoros) > len(old.Pomodoros):
+		return "pomodoro", "todo: pomodoro on " + title
+	}
+	return "edit", "todo: edit " + title
+}
+
+// shortTitle keeps commit subjects on one readable line
+func shortTitle(title string) string {
+	title = strings.Join(strings.Fields(title), " ")
+	if runes := []rune(title); len(runes) > 50 {
+		return string(runes[:47]) + "..."
+	}
+	return title
+}
+
+// jsonEqual reports whether a and b serialize identically (what ends up on disk)
+func jsonEqual(a, b any) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(aJSON, bJSON)
+}
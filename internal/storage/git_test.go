@@ -0,0 +1,286 @@
+package storage
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// requireGit skips tests that need the git binary
+func requireGit(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+}
+
+// useHome points storage at home for the rest of the test
+func useHome(t *testing.T, home string) {
+	t.Helper()
+	originalHome, hadHome := os.LookupEnv("HOME")
+	os.Setenv("HOME", home)
+	t.Cleanup(func() {
+		if hadHome {
+			os.Setenv("HOME", originalHome)
+		}
+	})
+}
+
+// lastCommit returns the subject of the latest commit in the data directory
+func lastCommit(t *testing.T) string {
+	t.Helper()
+	dir, _ := GetAmosDir()
+	subject, err := runGit(dir, "log", "-1", "--format=%s")
+	if err != nil {
+		t.Fatalf("git log failed: %v", err)
+	}
+	return subject
+}
+
+func TestVersioningCommitsSaves(t *testing.T) {
+	requireGit(t)
+	useHome(t, t.TempDir())
+
+	if IsVersioned() {
+		t.Fatal("Expected a fresh data directory not to be versioned")
+	}
+	if err := SaveEntry(models.Entry{ID: "e1", Title: "First"}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if err := InitVersioning(); err != nil {
+		t.Fatalf("InitVersioning() failed: %v", err)
+	}
+	if !IsVersioned() {
+		t.Fatal("Expected the data directory to be versioned")
+	}
+	if got := lastCommit(t); got != "amos: start versioning (1 entries, 0 todos)" {
+		t.Errorf("Initial commit = %q", got)
+	}
+
+	now := time.Now()
+	steps := []struct {
+		name string
+		save func() error
+		want string
+	}{
+		{"add", func() error {
+			return SaveTodo(models.Todo{ID: "t1", Title: "Fix build", Status: "open", CreatedAt: now})
+		}, "todo: add Fix build"},
+		{"status", func() error {
+			_, err := UpdateTodo("t1", func(todo *models.Todo) error { todo.Status = "done"; return nil })
+			return err
+		}, "todo: Fix build → done"},
+		{"archive", func() error {
+			_, err := ArchiveTodos(map[string]bool{"t1": true}, now)
+			return err
+		}, "todo: archive Fix build"},
+		{"entry", func() error {
+			return SaveEntry(models.Entry{ID: "e2", Title: "Standup"})
+		}, "entry: add Standup"},
+		{"config", func() error {
+			return SaveTagAlias("js", "javascript")
+		}, "config: update settings"},
+	}
+
+	for _, step := range steps {
+		if err := step.save(); err != nil {
+			t.Fatalf("%s: save failed: %v", step.name, err)
+		}
+		if got := lastCommit(t); got != step.want {
+			t.Errorf("%s: commit = %q, want %q", step.name, got, step.want)
+		}
+	}
+
+	// The undo log stays out of the history
+	if err := SaveUndoLog(models.UndoLog{}); err != nil {
+		t.Fatalf("SaveUndoLog() failed: %v", err)
+	}
+	dir, _ := GetAmosDir()
+	if status, _ := runGit(dir, "status", "--porcelain"); status != "" {
+		t.Errorf("Expected a clean working tree, got %q", status)
+	}
+}
+
+func TestDescribeChangesSeveral(t *testing.T) {
+	before := dataState{todos: []locatedTodo{
+		{File: todosFile, Todo: models.Todo{ID: "1", Title: "One", Status: "open"}},
+		{File: todosFile, Todo: models.Todo{ID: "2", Title: "Two", Status: "open"}},
+	}}
+	after := dataState{todos: []locatedTodo{
+		{File: todosFile, Todo: models.Todo{ID: "1", Title: "One", Status: "done"}},
+		{File: trashFile, Todo: models.Todo{ID: "2", Title: "Two", Status: "open"}},
+	}}
+
+	got := describeChanges(before, after, nil, false)
+	want := "todo: One → done (+1 more)\n\ntodo: One → done\ntodo: delete Two"
+	if got != want {
+		t.Errorf("describeChanges() = %q, want %q", got, want)
+	}
+
+	// Redacted: counts and IDs only
+	after.entries = []models.Entry{{ID: "e1", Title: "HR complaint"}}
+	got = describeChanges(before, after, nil, true)
+	want = "entry: add 1, todo: set done 1, todo: delete 1\n\nentry: add e1\ntodo: set done 1\ntodo: delete 2"
+	if got != want {
+		t.Errorf("describeChanges(redact) = %q, want %q", got, want)
+	}
+
+	// Private entries and their todos keep their titles out even unencrypted
+	entryID := "e2"
+	before = dataState{entries: []models.Entry{{ID: "e2", Title: "Therapy", Tags: []string{"private"}}}}
+	after = dataState{
+		entries: []models.Entry{{ID: "e2", Title: "Therapy notes", Tags: []string{"private"}}},
+		todos:   []locatedTodo{{File: todosFile, Todo: models.Todo{ID: "3", Title: "Call therapist", Status: "open", EntryID: &entryID}}},
+	}
+	got = describeChanges(before, after, nil, false)
+	want = "entry: edit (private) (+1 more)\n\nentry: edit (private)\ntodo: add (private)"
+	if got != want {
+		t.Errorf("describeChanges(private) = %q, want %q", got, want)
+	}
+}
+
+func TestSync(t *testing.T) {
+	requireGit(t)
+
+	remote := filepath.Join(t.TempDir(), "journal.git")
+	if out, err := exec.Command("git", "init", "-q", "--bare", remote).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare failed: %v %s", err, out)
+	}
+	laptop, desktop := t.TempDir(), t.TempDir()
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	// sync switches HOME to a machine and syncs it
+	sync := func(home string) SyncResult {
+		t.Helper()
+		useHome(t, home)
+		result, err := Sync(remote)
+		if err != nil {
+			t.Fatalf("Sync() failed: %v", err)
+		}
+		return result
+	}
+
+	// Both machines start with their own data (unrelated histories)
+	useHome(t, laptop)
+	if err := SaveTodo(models.Todo{ID: "shared", Title: "Shared", Status: "open", CreatedAt: created}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	if err := InitVersioning(); err != nil {
+		t.Fatalf("InitVersioning() failed: %v", err)
+	}
+	if result := sync(laptop); !result.Pushed || result.Pulled {
+		t.Errorf("First sync: expected a push only, got %+v", result)
+	}
+
+	useHome(t, desktop)
+	if err := InitVersioning(); err != nil {
+		t.Fatalf("InitVersioning() failed: %v", err)
+	}
+	if err := SaveEntry(models.Entry{ID: "desk", Title: "From the desktop", Timestamp: created}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if result := sync(desktop); !result.Pulled || !result.Pushed {
+		t.Errorf("Desktop sync: expected pull and push, got %+v", result)
+	}
+	if result := sync(laptop); !result.Pulled || result.Pushed {
+		t.Errorf("Laptop sync: expected a fast-forward pull, got %+v", result)
+	}
+	if entries, _ := LoadEntries(); len(entries) != 1 || entries[0].ID != "desk" {
+		t.Fatalf("Expected the desktop entry on the laptop, got %+v", entries)
+	}
+
+	// Same todo changed differently on both machines: the later activity wins on both
+	useHome(t, laptop)
+	done := created.Add(2 * time.Hour)
+	if _, err := UpdateTodo("shared", func(todo *models.Todo) error {
+		todo.Status = "done"
+		todo.CompletedAt = &done
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTodo() failed: %v", err)
+	}
+	if err := SaveTodo(models.Todo{ID: "laptop", Title: "Laptop only", Status: "open", CreatedAt: created}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	useHome(t, desktop)
+	if _, err := UpdateTodo("shared", func(todo *models.Todo) error { todo.Title = "Shared (renamed)"; return nil }); err != nil {
+		t.Fatalf("UpdateTodo() failed: %v", err)
+	}
+
+	sync(laptop)
+	if result := sync(desktop); result.Conflicts != 1 {
+		t.Errorf("Expected 1 conflict, got %+v", result)
+	}
+	sync(laptop)
+
+	for _, home := range []string{laptop, desktop} {
+		useHome(t, home)
+		todos, err := LoadTodos()
+		if err != nil {
+			t.Fatalf("LoadTodos() failed: %v", err)
+		}
+		if len(todos) != 2 {
+			t.Fatalf("%s: expected 2 todos, got %+v", home, todos)
+		}
+		shared := todos[todoIndex(todos, "shared")]
+		if shared.Status != "done" || shared.Title != "Shared" {
+			t.Errorf("%s: expected the later (done) version to win, got %+v", home, shared)
+		}
+	}
+
+	useHome(t, desktop)
+	if got := lastCommit(t); !strings.HasPrefix(got, "sync: merge origin/main (1 conflicts") {
+		t.Errorf("Merge commit = %q", got)
+	}
+}
+
+// todoIndex returns the index of the todo with id (-1 if missing)
+func todoIndex(todos []models.Todo, id string) int {
+	for i, todo := range todos {
+		if todo.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestMergeByID(t *testing.T) {
+	base := []models.Entry{{ID: "kept"}, {ID: "removed"}, {ID: "edited-removed"}}
+	ours := []models.Entry{{ID: "kept"}, {ID: "edited-removed", Title: "edited"}, {ID: "ours-new"}}
+	theirs := []models.Entry{{ID: "kept", Title: "theirs"}, {ID: "removed"}, {ID: "theirs-new"}}
+
+	merged, conflicts := mergeByID(base, ours, theirs,
+		func(e models.Entry) string { return e.ID },
+		func(a, b models.Entry) models.Entry { return a })
+
+	var got []string
+	for _, entry := range merged {
+		got = append(got, entry.ID+"="+entry.Title)
+	}
+	want := "kept=theirs edited-removed=edited ours-new= theirs-new="
+	if strings.Join(got, " ") != want {
+		t.Errorf("mergeByID() = %q, want %q", strings.Join(got, " "), want)
+	}
+	if conflicts != 0 {
+		t.Errorf("Expected no conflicts, got %d", conflicts)
+	}
+}
+
+func TestNewerIsSymmetric(t *testing.T) {
+	at := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	a := models.Entry{ID: "1", Title: "A", Timestamp: at}
+	b := models.Entry{ID: "1", Title: "B", Timestamp: at}
+
+	if newer(a, b, at, at).Title != newer(b, a, at, at).Title {
+		t.Error("Expected the tie-break not to depend on argument order")
+	}
+	later := models.Entry{ID: "1", Title: "Later", Timestamp: at.Add(time.Hour)}
+	if got := newer(a, later, a.Timestamp, later.Timestamp); got.Title != "Later" {
+		t.Errorf("Expected the later version, got %q", got.Title)
+	}
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// lockFile is held (exclusively) while a storage function writes, so the TUI,
+// "amos serve" and CLI commands running at the same time never interleave
+// a load-modify-save of the same file
+const lockFile = "amos.lock"
+
+// processLock serializes writers inside one process (the file lock covers other processes)
+var processLock sync.Mutex
+
+// withLock runs fn while holding the storage lock
+// fn must only use unexported helpers (loadX/saveX): the lock is not reentrant
+// When the data directory is versioned (git), what fn wrote is committed before the lock is released
+func withLock(fn func() error) error {
+	return withRawLock(func() error {
+		if err := fn(); err != nil {
+			return err
+		}
+		return commitIfVersioned()
+	})
+}
+
+// withRawLock runs fn while holding the storage lock, without committing
+// The data files are snapshotted first when the last backup is over an hour old
+func withRawLock(fn func() error) error {
+	processLock.Lock()
+	defer processLock.Unlock()
+
+	if err := EnsureAmosDir(); err != nil {
+		return err
+	}
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, lockFile), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := lockFileExclusive(f); err != nil {
+		return err
+	}
+	defer unlockFile(f)
+
+	// A failed backup must not block the write: the data files themselves are fine
+	_ = snapshotIfDue(dir)
+
+	return fn()
+}
+
+// writeFileAtomic writes data to a temp file beside path and renames it into place,
+// so readers (which don't take the lock) never see a half-written file
+// Files are readable by the user only: journals hold private notes
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// Before the rename: the watcher may see the new file right away
+	rememberWrite(path, data)
+	return os.Rename(tmp.Name(), path)
+}
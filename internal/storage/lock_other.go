@@ -0,0 +1,15 @@
+//go:build !unix
+
+package storage
+
+import "os"
+
+// lockFileExclusive is a no-op without flock: processLock still serializes writers in one process
+func lockFileExclusive(f *os.File) error {
+	return nil
+}
+
+// unlockFile is a no-op without flock
+func unlockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestConcurrentSaveTodo(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	// Each SaveTodo is a load-modify-save: without the lock some would be lost
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := SaveTodo(models.Todo{ID: fmt.Sprintf("todo-%d", i), Status: "open"}); err != nil {
+				t.Errorf("SaveTodo() failed: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	todos, err := LoadTodos()
+	if err != nil {
+		t.Fatalf("LoadTodos() failed: %v", err)
+	}
+	if len(todos) != 20 {
+		t.Errorf("Expected 20 todos after concurrent saves, got %d", len(todos))
+	}
+
+	// Atomic writes leave no temp files behind
+	files, _ := os.ReadDir(filepath.Join(tempDir, ".amos"))
+	for _, f := range files {
+		if strings.Contains(f.Name(), ".tmp") {
+			t.Errorf("Leftover temp file %s", f.Name())
+		}
+	}
+}
+
+func TestUpdateTodo(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	SaveTodos([]models.Todo{{ID: "1", Title: "a", Status: "open"}, {ID: "2", Title: "b", Status: "open"}})
+
+	updated, err := UpdateTodo("2", func(todo *models.Todo) error {
+		todo.Status = "next"
+		return nil
+	})
+	if err != nil || updated.Status != "next" {
+		t.Fatalf("UpdateTodo() = %+v, %v", updated, err)
+	}
+	todos, _ := LoadTodos()
+	if todos[1].Status != "next" || todos[0].Status != "open" {
+		t.Errorf("Expected only todo 2 changed, got %+v", todos)
+	}
+
+	if _, err := UpdateTodo("missing", func(*models.Todo) error { return nil }); !errors.Is(err, ErrTodoNotFound) {
+		t.Errorf("Expected ErrTodoNotFound, got %v", err)
+	}
+
+	// An error from fn leaves the file untouched
+	boom := errors.New("boom")
+	if _, err := UpdateTodo("1", func(todo *models.Todo) error {
+		todo.Status = "done"
+		return boom
+	}); !errors.Is(err, boom) {
+		t.Errorf("Expected fn error, got %v", err)
+	}
+	if todos, _ := LoadTodos(); todos[0].Status != "open" {
+		t.Error("Expected todo 1 unchanged after a failed update")
+	}
+}
+
+func TestConcurrentMultiRecordWrites(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	SaveTodos([]models.Todo{{ID: "counter", Title: "0", Status: "open"}})
+
+	// Each writer is a load-modify-save of several files: without the lock some would be lost
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(3)
+		go func(i int) {
+			defer wg.Done()
+			err := UpdateTodos(func(todos []models.Todo) ([]models.Todo, error) {
+				return append(todos, models.Todo{ID: fmt.Sprintf("bulk-%d", i), Status: "open"}), nil
+			})
+			if err != nil {
+				t.Errorf("UpdateTodos() failed: %v", err)
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			entryID := fmt.Sprintf("entry-%d", i)
+			todo := models.Todo{ID: fmt.Sprintf("linked-%d", i), Status: "open", EntryID: &entryID}
+			if _, err := SaveEntryWithTodos(models.Entry{ID: entryID, TodoIDs: []string{todo.ID}}, []models.Todo{todo}); err != nil {
+				t.Errorf("SaveEntryWithTodos() failed: %v", err)
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			err := UpdateDataFiles(func(files *DataFiles) error {
+				files.Archived = append(files.Archived, models.Todo{ID: fmt.Sprintf("archived-%d", i), Status: "done"})
+				return nil
+			})
+			if err != nil {
+				t.Errorf("UpdateDataFiles() failed: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	files, err := LoadDataFiles()
+	if err != nil {
+		t.Fatalf("LoadDataFiles() failed: %v", err)
+	}
+	if len(files.Todos) != 21 || len(files.Entries) != 10 || len(files.Archived) != 10 {
+		t.Errorf("Got %d todos, %d entries, %d archived; want 21, 10, 10", len(files.Todos), len(files.Entries), len(files.Archived))
+	}
+
+	// A failing update saves nothing
+	failed := errors.New("nope")
+	err = UpdateDataFiles(func(files *DataFiles) error {
+		files.Todos = nil
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("Expected the fn error, got %v", err)
+	}
+	if todos, _ := LoadTodos(); len(todos) != 21 {
+		t.Errorf("Expected todos untouched after a failed update, got %d", len(todos))
+	}
+
+	// Re-saving an entry returns the version it replaced
+	previous, err := SaveEntryWithTodos(models.Entry{ID: "entry-1", Title: "edited"}, nil)
+	if err != nil || previous == nil || previous.ID != "entry-1" || previous.Title != "" {
+		t.Errorf("SaveEntryWithTodos() previous = %+v, %v", previous, err)
+	}
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// DataFiles is the content of every file entries and todos live in (for integrity checks)
+type DataFiles struct {
+	Entries  []models.Entry
+	Todos    []models.Todo
+	Archived []models.Todo
+	Trashed  []models.Todo
+}
+
+// LoadDataFiles loads entries.json, todos.json, archive.json and trash.json
+func LoadDataFiles() (DataFiles, error) {
+	var files DataFiles
+	var err error
+	if files.Entries, err = LoadEntries(); err != nil {
+		return DataFiles{}, err
+	}
+	if files.Todos, err = LoadTodos(); err != nil {
+		return DataFiles{}, err
+	}
+	if files.Archived, err = LoadArchivedTodos(); err != nil {
+		return DataFiles{}, err
+	}
+	if files.Trashed, err = LoadTrashedTodos(); err != nil {
+		return DataFiles{}, err
+	}
+	return files, nil
+}
+
+// RepairDataFiles snapshots the data files, then loads them, applies fix and saves the ones it changed,
+// all under the storage lock. Returns the snapshot taken (restore it to undo the repair)
+func RepairDataFiles(fix func(*DataFiles)) (Snapshot, error) {
+	var snapshot Snapshot
+	err := withLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+		if snapshot, err = takeSnapshot(dir, time.Now()); err != nil {
+			return err
+		}
+		return updateDataFiles(func(files *DataFiles) error { fix(files); return nil })
+	})
+	return snapshot, err
+}
+
+// UpdateDataFiles loads every data file, applies fn and saves the ones it changed, all under the storage lock
+// For changes spanning files (undo, tag renames, moving todos to the archive or trash); nothing is saved if fn fails
+func UpdateDataFiles(fn func(*DataFiles) error) error {
+	return withLock(func() error { return updateDataFiles(fn) })
+}
+
+// updateDataFiles is UpdateDataFiles without taking the storage lock
+// Archive and trash are written before todos.json, so a failure part-way never loses todos (worst case: a duplicate)
+func updateDataFiles(fn func(*DataFiles) error) error {
+	before, err := LoadDataFiles()
+	if err != nil {
+		return err
+	}
+	after, err := LoadDataFiles() // A separate copy: fn may change slices in place
+	if err != nil {
+		return err
+	}
+	if err := fn(&after); err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(before.Entries, after.Entries) {
+		if err := saveEntries(after.Entries); err != nil {
+			return err
+		}
+	}
+	if !reflect.DeepEqual(before.Archived, after.Archived) {
+		if err := saveTodoFile(archiveFile, after.Archived); err != nil {
+			return err
+		}
+	}
+	if !reflect.DeepEqual(before.Trashed, after.Trashed) {
+		if err := saveTodoFile(trashFile, after.Trashed); err != nil {
+			return err
+		}
+	}
+	if !reflect.DeepEqual(before.Todos, after.Todos) {
+		if err := saveTodos(after.Todos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestRepairDataFiles(t *testing.T) {
+	useHome(t, t.TempDir())
+	if err := SaveEntry(models.Entry{ID: "e1", Title: "Standup", TodoIDs: []string{"missing"}}); err != nil {
+		t.Fatalf("SaveEntry() failed: %v", err)
+	}
+	if err := SaveTodos([]models.Todo{{ID: "t1", Title: "Deploy", Status: "Open"}}); err != nil {
+		t.Fatalf("SaveTodos() failed: %v", err)
+	}
+
+	snapshot, err := RepairDataFiles(func(files *DataFiles) {
+		files.Todos[0].Status = "open"
+	})
+	if err != nil {
+		t.Fatalf("RepairDataFiles() failed: %v", err)
+	}
+
+	files, err := LoadDataFiles()
+	if err != nil {
+		t.Fatalf("LoadDataFiles() failed: %v", err)
+	}
+	if files.Todos[0].Status != "open" || len(files.Entries[0].TodoIDs) != 1 {
+		t.Errorf("Expected only the todo status changed, got %+v", files)
+	}
+
+	// Untouched files aren't written (archive.json and trash.json don't appear)
+	dir, _ := GetAmosDir()
+	if _, err := os.Stat(filepath.Join(dir, archiveFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected no archive.json, got %v", err)
+	}
+
+	// The snapshot holds the data from before the repair
+	data, err := os.ReadFile(filepath.Join(dir, backupsDir, snapshot.Name, todosFile))
+	if err != nil || !bytes.Contains(data, []byte(`"Open"`)) {
+		t.Errorf("Expected the snapshot to hold the unrepaired todos (%v)", err)
+	}
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	reviewsDir    = "reviews"
+	timesheetsDir = "timesheets"
+)
+
+// SaveReview writes a Markdown weekly review to ~/.amos/reviews/<weekID>.md
+// Returns the path written so the caller can show it
+func SaveReview(weekID, markdown string) (string, error) {
+	return saveExport(reviewsDir, weekID+".md", markdown)
+}
+
+// SaveTimesheet writes a CSV timesheet to ~/.amos/timesheets/<weekID>.csv
+// Returns the path written so the caller can show it
+func SaveTimesheet(weekID, csv string) (string, error) {
+	return saveExport(timesheetsDir, weekID+".csv", csv)
+}
+
+// saveExport writes content to ~/.amos/<subdir>/<name>, creating the directory if needed
+func saveExport(subdir, name, content string) (string, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return "", err
+	}
+
+	exportDir := filepath.Join(dir, subdir)
+	if err := os.MkdirAll(exportDir, 0700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(exportDir, name)
+	if err := withLock(func() error { return writeFileAtomic(path, []byte(content)) }); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
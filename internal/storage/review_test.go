@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveReview(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	path, err := SaveReview("2026-W42", "# Weekly Review 2026-W42\n")
+	if err != nil {
+		t.Fatalf("SaveReview() failed: %v", err)
+	}
+
+	want := filepath.Join(tempDir, ".amos", "reviews", "2026-W42.md")
+	if path != want {
+		t.Errorf("SaveReview() path = %q, want %q", path, want)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read review: %v", err)
+	}
+	if string(data) != "# Weekly Review 2026-W42\n" {
+		t.Errorf("Review content = %q", string(data))
+	}
+}
+
+func TestSaveTimesheet(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	path, err := SaveTimesheet("2026-W43", "tag,total\ndev,1.50\n")
+	if err != nil {
+		t.Fatalf("SaveTimesheet() failed: %v", err)
+	}
+
+	want := filepath.Join(tempDir, ".amos", "timesheets", "2026-W43.csv")
+	if path != want {
+		t.Errorf("SaveTimesheet() path = %q, want %q", path, want)
+	}
+}
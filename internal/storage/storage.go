@@ -2,6 +2,7 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 
@@ -14,6 +15,9 @@ const (
 	todosFile   = "todos.json"
 )
 
+// ErrTodoNotFound is returned by UpdateTodo when no todo in todos.json has the ID
+var ErrTodoNotFound = errors.New("todo not found")
+
 // GetAmosDir returns the path to ~/.amos directory
 func GetAmosDir() (string, error) {
 	home, err := os.UserHomeDir()
@@ -23,13 +27,13 @@ func GetAmosDir() (string, error) {
 	return filepath.Join(home, amosDir), nil
 }
 
-// EnsureAmosDir creates ~/.amos directory if it doesn't exist
+// EnsureAmosDir creates ~/.amos directory (private to the user) if it doesn't exist
 func EnsureAmosDir() error {
 	dir, err := GetAmosDir()
 	if err != nil {
 		return err
 	}
-	return os.MkdirAll(dir, 0755)
+	return os.MkdirAll(dir, 0700)
 }
 
 // LoadEntries loads all entries from entries.json
@@ -46,7 +50,7 @@ func LoadEntries() ([]models.Entry, error) {
 		return []models.Entry{}, nil
 	}
 
-	data, err := os.ReadFile(path)
+	data, err := readDataFile(path)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +65,11 @@ func LoadEntries() ([]models.Entry, error) {
 
 // SaveEntries saves all entries to entries.json
 func SaveEntries(entries []models.Entry) error {
+	return withLock(func() error { return saveEntries(entries) })
+}
+
+// saveEntries is SaveEntries without taking the storage lock
+func saveEntries(entries []models.Entry) error {
 	if err := EnsureAmosDir(); err != nil {
 		return err
 	}
@@ -77,11 +86,16 @@ func SaveEntries(entries []models.Entry) error {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	return writeDataFile(path, data)
 }
 
 // SaveEntry saves or updates a single entry in the entries list
 func SaveEntry(entry models.Entry) error {
+	return withLock(func() error { return saveEntry(entry) })
+}
+
+// saveEntry is SaveEntry without taking the storage lock
+func saveEntry(entry models.Entry) error {
 	entries, err := LoadEntries()
 	if err != nil {
 		return err
@@ -101,7 +115,7 @@ func SaveEntry(entry models.Entry) error {
 		entries = append(entries, entry)
 	}
 
-	return SaveEntries(entries)
+	return saveEntries(entries)
 }
 
 // LoadTodos loads all todos from todos.json
@@ -118,7 +132,7 @@ func LoadTodos() ([]models.Todo, error) {
 		return []models.Todo{}, nil
 	}
 
-	data, err := os.ReadFile(path)
+	data, err := readDataFile(path)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +147,11 @@ func LoadTodos() ([]models.Todo, error) {
 
 // SaveTodos saves all todos to todos.json
 func SaveTodos(todos []models.Todo) error {
+	return withLock(func() error { return saveTodos(todos) })
+}
+
+// saveTodos is SaveTodos without taking the storage lock
+func saveTodos(todos []models.Todo) error {
 	if err := EnsureAmosDir(); err != nil {
 		return err
 	}
@@ -149,11 +168,16 @@ func SaveTodos(todos []models.Todo) error {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	return writeDataFile(path, data)
 }
 
 // SaveTodo saves or updates a single todo in the todos list
 func SaveTodo(todo models.Todo) error {
+	return withLock(func() error { return saveTodo(todo) })
+}
+
+// saveTodo is SaveTodo without taking the storage lock
+func saveTodo(todo models.Todo) error {
 	todos, err := LoadTodos()
 	if err != nil {
 		return err
@@ -173,5 +197,103 @@ func SaveTodo(todo models.Todo) error {
 		todos = append(todos, todo)
 	}
 
-	return SaveTodos(todos)
+	return saveTodos(todos)
+}
+
+// UpdateTodo loads the todo with the given ID, applies fn and saves it, all under the storage lock
+// (no other writer can change todos.json in between); returns the updated todo
+func UpdateTodo(id string, fn func(*models.Todo) error) (models.Todo, error) {
+	var updated models.Todo
+	err := withLock(func() error {
+		todos, err := LoadTodos()
+		if err != nil {
+			return err
+		}
+		for i := range todos {
+			if todos[i].ID != id {
+				continue
+			}
+			if err := fn(&todos[i]); err != nil {
+				return err
+			}
+			updated = todos[i]
+			return saveTodos(todos)
+		}
+		return ErrTodoNotFound
+	})
+	return updated, err
+}
+
+// UpdateTodos loads todos.json, applies fn and saves what it returns, all under the storage lock
+// For edits of several todos at once (bulk actions, timers); nothing is saved if fn returns an error
+func UpdateTodos(fn func([]models.Todo) ([]models.Todo, error)) error {
+	return withLock(func() error {
+		todos, err := LoadTodos()
+		if err != nil {
+			return err
+		}
+		updated, err := fn(todos)
+		if err != nil {
+			return err
+		}
+		return saveTodos(updated)
+	})
+}
+
+// SaveEntryWithTodos saves an entry and the todos created in it in one locked write (one commit)
+// Todos are written first; if the entry then fails, todos.json is put back so no todo is left without its entry
+// Returns the version of the entry it replaced (nil for a new entry)
+func SaveEntryWithTodos(entry models.Entry, todos []models.Todo) (*models.Entry, error) {
+	var previous *models.Entry
+	err := withLock(func() error {
+		entries, err := LoadEntries()
+		if err != nil {
+			return err
+		}
+		found := false
+		for i := range entries {
+			if entries[i].ID == entry.ID {
+				old := entries[i]
+				previous = &old
+				entries[i] = entry
+				found = true
+				break
+			}
+		}
+		if !found {
+			entries = append(entries, entry)
+		}
+
+		if len(todos) == 0 {
+			return saveEntries(entries)
+		}
+		existing, err := LoadTodos()
+		if err != nil {
+			return err
+		}
+		updated := append([]models.Todo{}, existing...)
+		for _, todo := range todos {
+			updated = upsertTodo(updated, todo)
+		}
+		if err := saveTodos(updated); err != nil {
+			return err
+		}
+		if err := saveEntries(entries); err != nil {
+			_ = saveTodos(existing)
+			return err
+		}
+		return nil
+	})
+	return previous, err
+}
+
+// upsertTodo replaces the todo with the same ID, or appends it
+func upsertTodo(todos []models.Todo, todo models.Todo) []models.Todo {
+	for i := range todos {
+		if todos[i].ID == todo.ID {
+			todos[i] = todo
+			return todos
+		}
+	}
+	return append(todos, todo)
 }
@@ -0,0 +1,338 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+const (
+	syncBranch    = "main"   // Branch amos commits to and syncs
+	syncRemote    = "origin" // Name of the remote in the data repository
+	syncRemoteRef = "refs/remotes/origin/main"
+)
+
+// SyncResult says what Sync did
+type SyncResult struct {
+	Pulled    bool // Remote commits were merged in
+	Pushed    bool // Local commits were pushed
+	Conflicts int  // Entries/todos changed differently on both sides (resolved by the conflict policy)
+}
+
+// Sync pulls from and pushes to remote (a URL or a path to a bare repository)
+// Diverged histories are merged with git, but entries and todos are merged by ID:
+// an item changed on one side takes that side's version, an item changed differently on both
+// sides keeps the version with the latest activity (ties: the larger JSON), so every machine
+// resolves a conflict the same way. Writers wait on the storage lock while a sync runs.
+func Sync(remote string) (SyncResult, error) {
+	var result SyncResult
+	err := withRawLock(func() error {
+		dir, err := GetAmosDir()
+		if err != nil {
+			return err
+		}
+		if !isVersioned(dir) {
+			return ErrNotVersioned
+		}
+
+		if err := setRemote(dir, remote); err != nil {
+			return err
+		}
+
+		// Edits made outside amos (e.g. by hand) are committed first
+		if err := commitWorkingTree(dir); err != nil {
+			return err
+		}
+
+		if _, err := runGit(dir, "fetch", "-q", syncRemote); err != nil {
+			return err
+		}
+		if _, err := runGit(dir, "rev-parse", "-q", "--verify", syncRemoteRef); err != nil {
+			// Empty remote: publish our history
+			result.Pushed = true
+			return pushBranch(dir)
+		}
+
+		counts, err := runGit(dir, "rev-list", "--left-right", "--count", "HEAD..."+syncRemoteRef)
+		if err != nil {
+			return err
+		}
+		ahead, behind, err := parseAheadBehind(counts)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case behind == 0:
+			// Nothing to pull
+		case ahead == 0:
+			if _, err := runGitAsUser(dir, "merge", "-q", "--ff-only", syncRemoteRef); err != nil {
+				return err
+			}
+			result.Pulled = true
+		default:
+			conflicts, err := mergeRemote(dir)
+			if err != nil {
+				return err
+			}
+			result.Pulled = true
+			result.Conflicts = conflicts
+		}
+
+		if ahead > 0 {
+			result.Pushed = true
+			return pushBranch(dir)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// setRemote points the "origin" remote at url
+func setRemote(dir, url string) error {
+	current, err := runGit(dir, "remote", "get-url", syncRemote)
+	if err != nil {
+		_, err = runGit(dir, "remote", "add", syncRemote, url)
+		return err
+	}
+	if current != url {
+		_, err = runGit(dir, "remote", "set-url", syncRemote, url)
+	}
+	return err
+}
+
+// pushBranch pushes the local branch to the remote
+func pushBranch(dir string) error {
+	if _, err := runGit(dir, "push", "-q", syncRemote, "HEAD:refs/heads/"+syncBranch); err != nil {
+		return fmt.Errorf("%w (if the remote changed meanwhile, run sync again)", err)
+	}
+	return nil
+}
+
+// parseAheadBehind parses "git rev-list --left-right --count" output ("<ahead>\t<behind>")
+func parseAheadBehind(counts string) (ahead, behind int, err error) {
+	fields := strings.Fields(counts)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output %q", counts)
+	}
+	if ahead, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	behind, err = strconv.Atoi(fields[1])
+	return ahead, behind, err
+}
+
+// mergeRemote merges the remote branch into the local one and returns the number of conflicts
+// git records the merge (and merges other files such as exports); the data files are
+// rewritten from the by-ID merge, never merged as text
+func mergeRemote(dir string) (int, error) {
+	// Two machines that both started with data have no common history: merge from nothing
+	var base dataState
+	if mergeBase, err := runGit(dir, "merge-base", "HEAD", syncRemoteRef); err == nil {
+		if base, err = loadStateAt(dir, mergeBase); err != nil {
+			return 0, err
+		}
+	}
+	ours, err := loadStateAt(dir, "HEAD")
+	if err != nil {
+		return 0, err
+	}
+	theirs, err := loadStateAt(dir, syncRemoteRef)
+	if err != nil {
+		return 0, err
+	}
+	merged, conflicts := mergeStates(base, ours, theirs)
+
+	// A text conflict makes git merge fail but still leaves the merge in progress
+	_, mergeErr := runGitAsUser(dir, "merge", "-q", "--no-ff", "--no-commit", "--allow-unrelated-histories", syncRemoteRef)
+	if _, err := runGit(dir, "rev-parse", "-q", "--verify", "MERGE_HEAD"); err != nil {
+		if mergeErr != nil {
+			return 0, mergeErr
+		}
+		return 0, err
+	}
+
+	// Other conflicting files keep our version
+	unmerged, err := runGit(dir, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return 0, err
+	}
+	for _, path := range strings.Fields(unmerged) {
+		if _, err := runGit(dir, "checkout", "--ours", "--", path); err != nil {
+			// Deleted on our side
+			if _, err := runGit(dir, "rm", "-q", "--", path); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := writeState(dir, merged); err != nil {
+		return 0, err
+	}
+	if _, err := runGit(dir, "add", "-A"); err != nil {
+		return 0, err
+	}
+
+	message := "sync: merge " + syncRemote + "/" + syncBranch
+	if conflicts > 0 {
+		message += fmt.Sprintf(" (%d conflicts, latest change kept)", conflicts)
+	}
+	return conflicts, gitCommit(dir, message)
+}
+
+// writeState writes merged entries, todos and config to the data files
+func writeState(dir string, state dataState) error {
+	if err := saveEntries(nonNilEntries(state.entries)); err != nil {
+		return err
+	}
+
+	byFile := map[string][]models.Todo{}
+	for _, located := range state.todos {
+		byFile[located.File] = append(byFile[located.File], located.Todo)
+	}
+	for _, name := range todoFiles {
+		todos := byFile[name]
+		if todos == nil {
+			todos = []models.Todo{}
+		}
+		if err := saveTodoFile(name, todos); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(dir, configFile)
+	if state.config == nil {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return writeFileAtomic(path, state.config)
+}
+
+// nonNilEntries keeps an empty journal as [] rather than null
+func nonNilEntries(entries []models.Entry) []models.Entry {
+	if entries == nil {
+		return []models.Entry{}
+	}
+	return entries
+}
+
+// mergeStates three-way merges two versions of the journal against their common base
+// Returns the merged state and how many items were changed differently on both sides
+func mergeStates(base, ours, theirs dataState) (dataState, int) {
+	entries, entryConflicts := mergeByID(base.entries, ours.entries, theirs.entries,
+		func(e models.Entry) string { return e.ID },
+		func(a, b models.Entry) models.Entry { return newer(a, b, a.Timestamp, b.Timestamp) })
+
+	todos, todoConflicts := mergeByID(base.todos, ours.todos, theirs.todos,
+		func(t locatedTodo) string { return t.Todo.ID },
+		func(a, b locatedTodo) locatedTodo {
+			return newer(a, b, lastTodoActivity(a.Todo), lastTodoActivity(b.Todo))
+		})
+
+	// Settings: whichever side changed them (ours if both did)
+	config := ours.config
+	if bytes.Equal(bytes.TrimSpace(ours.config), bytes.TrimSpace(base.config)) {
+		config = theirs.config
+	}
+
+	return dataState{entries: entries, todos: todos, config: config}, entryConflicts + todoConflicts
+}
+
+// mergeByID three-way merges lists of items keyed by ID
+// Changed on one side: that side wins (including removals); changed on both: pick decides
+// A removal never beats a change. Order: ours, then items only the other side has.
+func mergeByID[T any](base, ours, theirs []T, id func(T) string, pick func(a, b T) T) ([]T, int) {
+	index := func(items []T) map[string]T {
+		m := make(map[string]T, len(items))
+		for _, item := range items {
+			m[id(item)] = item
+		}
+		return m
+	}
+	baseByID, oursByID, theirsByID := index(base), index(ours), index(theirs)
+
+	var merged []T
+	conflicts := 0
+	for _, o := range ours {
+		key := id(o)
+		b, inBase := baseByID[key]
+		t, inTheirs := theirsByID[key]
+		switch {
+		case !inTheirs:
+			// Removed by them: drop only if we didn't change it
+			if inBase && jsonEqual(o, b) {
+				continue
+			}
+			merged = append(merged, o)
+		case jsonEqual(o, t):
+			merged = append(merged, o)
+		case inBase && jsonEqual(o, b):
+			merged = append(merged, t)
+		case inBase && jsonEqual(t, b):
+			merged = append(merged, o)
+		default:
+			merged = append(merged, pick(o, t))
+			conflicts++
+		}
+	}
+	for _, t := range theirs {
+		key := id(t)
+		if _, inOurs := oursByID[key]; inOurs {
+			continue
+		}
+		// Removed by us: drop only if they didn't change it
+		if b, inBase := baseByID[key]; inBase && jsonEqual(t, b) {
+			continue
+		}
+		merged = append(merged, t)
+	}
+	return merged, conflicts
+}
+
+// newer returns the item with the later activity time; ties go to the larger JSON,
+// so both machines pick the same version no matter which side is "ours"
+func newer[T any](a, b T, aTime, bTime time.Time) T {
+	if aTime.After(bTime) {
+		return a
+	}
+	if bTime.After(aTime) {
+		return b
+	}
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+	if bytes.Compare(aJSON, bJSON) >= 0 {
+		return a
+	}
+	return b
+}
+
+// lastTodoActivity is the latest time recorded anywhere on a todo
+func lastTodoActivity(todo models.Todo) time.Time {
+	latest := todo.CreatedAt
+	consider := func(t *time.Time) {
+		if t != nil && t.After(latest) {
+			latest = *t
+		}
+	}
+	consider(todo.CompletedAt)
+	consider(todo.ArchivedAt)
+	consider(todo.RestoredAt)
+	consider(todo.DeletedAt)
+	for _, interval := range todo.TimeLog {
+		consider(&interval.Start)
+		consider(interval.End)
+	}
+	for _, pomodoro := range todo.Pomodoros {
+		consider(&pomodoro)
+	}
+	return latest
+}
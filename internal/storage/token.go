@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// tokenFile holds the bearer token "amos serve" generated for API clients
+const tokenFile = "api.token"
+
+// SaveAPIToken writes the API token to ~/.amos/api.token (readable only by the user)
+// Returns the path of the written file
+func SaveAPIToken(token string) (string, error) {
+	if err := EnsureAmosDir(); err != nil {
+		return "", err
+	}
+
+	dir, err := GetAmosDir()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, tokenFile)
+	if err := os.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+		return "", err
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(path, 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// trashFile holds deleted todos until they are restored or purged
+const trashFile = "trash.json"
+
+// LoadTrashedTodos loads all deleted todos from trash.json
+func LoadTrashedTodos() ([]models.Todo, error) {
+	return loadTodoFile(trashFile)
+}
+
+// SaveTrashedTodos saves all deleted todos to trash.json
+func SaveTrashedTodos(todos []models.Todo) error {
+	return withLock(func() error { return saveTodoFile(trashFile, todos) })
+}
+
+// TrashTodos moves the todos with the given IDs from todos.json to trash.json
+// Trash is written first so a failure part-way never loses todos (worst case: a duplicate)
+// Returns how many todos were deleted
+func TrashTodos(ids map[string]bool, now time.Time) (int, error) {
+	var n int
+	err := withLock(func() (err error) {
+		n, err = trashTodos(ids, now)
+		return err
+	})
+	return n, err
+}
+
+// trashTodos is TrashTodos without taking the storage lock
+func trashTodos(ids map[string]bool, now time.Time) (int, error) {
+	todos, err := LoadTodos()
+	if err != nil {
+		return 0, err
+	}
+	trashed, err := LoadTrashedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]models.Todo, 0, len(todos))
+	moved := 0
+	for _, todo := range todos {
+		if !ids[todo.ID] {
+			remaining = append(remaining, todo)
+			continue
+		}
+		deletedAt := now
+		todo.DeletedAt = &deletedAt
+		trashed = append(trashed, todo)
+		moved++
+	}
+
+	if moved == 0 {
+		return 0, nil
+	}
+
+	if err := saveTodoFile(trashFile, trashed); err != nil {
+		return 0, err
+	}
+	if err := saveTodos(remaining); err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+// RestoreTrashedTodos moves the todos with the given IDs from trash.json back to todos.json
+// Todos are written first so a failure part-way never loses todos (worst case: a duplicate)
+// Returns how many todos were restored
+func RestoreTrashedTodos(ids map[string]bool) (int, error) {
+	var n int
+	err := withLock(func() (err error) {
+		n, err = restoreTrashedTodos(ids)
+		return err
+	})
+	return n, err
+}
+
+// restoreTrashedTodos is RestoreTrashedTodos without taking the storage lock
+func restoreTrashedTodos(ids map[string]bool) (int, error) {
+	todos, err := LoadTodos()
+	if err != nil {
+		return 0, err
+	}
+	trashed, err := LoadTrashedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]models.Todo, 0, len(trashed))
+	restored := 0
+	for _, todo := range trashed {
+		if !ids[todo.ID] {
+			remaining = append(remaining, todo)
+			continue
+		}
+		todo.DeletedAt = nil
+		todos = append(todos, todo)
+		restored++
+	}
+
+	if restored == 0 {
+		return 0, nil
+	}
+
+	if err := saveTodos(todos); err != nil {
+		return 0, err
+	}
+	if err := saveTodoFile(trashFile, remaining); err != nil {
+		return 0, err
+	}
+
+	return restored, nil
+}
+
+// PurgeTrashedTodos permanently removes the todos with the given IDs from trash.json
+// Returns how many todos were purged
+func PurgeTrashedTodos(ids map[string]bool) (int, error) {
+	var n int
+	err := withLock(func() (err error) {
+		n, err = purgeTrashedTodos(ids)
+		return err
+	})
+	return n, err
+}
+
+// purgeTrashedTodos is PurgeTrashedTodos without taking the storage lock
+func purgeTrashedTodos(ids map[string]bool) (int, error) {
+	trashed, err := LoadTrashedTodos()
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]models.Todo, 0, len(trashed))
+	for _, todo := range trashed {
+		if !ids[todo.ID] {
+			remaining = append(remaining, todo)
+		}
+	}
+
+	purged := len(trashed) - len(remaining)
+	if purged == 0 {
+		return 0, nil
+	}
+	return purged, saveTodoFile(trashFile, remaining)
+}
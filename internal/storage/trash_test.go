@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestTrashAndRestoreTodos(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	if err := SaveTodos([]models.Todo{
+		{ID: "1", Title: "Keep", Status: "open"},
+		{ID: "2", Title: "test item", Status: "open"},
+	}); err != nil {
+		t.Fatalf("SaveTodos() failed: %v", err)
+	}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	moved, err := TrashTodos(map[string]bool{"2": true, "missing": true}, now)
+	if err != nil {
+		t.Fatalf("TrashTodos() failed: %v", err)
+	}
+	if moved != 1 {
+		t.Errorf("Expected 1 deleted, got %d", moved)
+	}
+
+	active, _ := LoadTodos()
+	if len(active) != 1 || active[0].ID != "1" {
+		t.Errorf("Expected only todo 1 active, got %v", active)
+	}
+	trashed, _ := LoadTrashedTodos()
+	if len(trashed) != 1 || trashed[0].DeletedAt == nil || !trashed[0].DeletedAt.Equal(now) {
+		t.Fatalf("Expected todo 2 in trash with DeletedAt, got %+v", trashed)
+	}
+
+	restored, err := RestoreTrashedTodos(map[string]bool{"2": true})
+	if err != nil {
+		t.Fatalf("RestoreTrashedTodos() failed: %v", err)
+	}
+	if restored != 1 {
+		t.Errorf("Expected 1 restored, got %d", restored)
+	}
+	active, _ = LoadTodos()
+	if len(active) != 2 || active[1].DeletedAt != nil {
+		t.Errorf("Expected todo 2 back without DeletedAt, got %+v", active)
+	}
+	trashed, _ = LoadTrashedTodos()
+	if len(trashed) != 0 {
+		t.Errorf("Expected empty trash, got %v", trashed)
+	}
+}
+
+func TestPurgeTrashedTodos(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	if err := SaveTrashedTodos([]models.Todo{{ID: "1"}, {ID: "2"}, {ID: "3"}}); err != nil {
+		t.Fatalf("SaveTrashedTodos() failed: %v", err)
+	}
+
+	purged, err := PurgeTrashedTodos(map[string]bool{"1": true, "3": true})
+	if err != nil {
+		t.Fatalf("PurgeTrashedTodos() failed: %v", err)
+	}
+	if purged != 2 {
+		t.Errorf("Expected 2 purged, got %d", purged)
+	}
+
+	trashed, _ := LoadTrashedTodos()
+	if len(trashed) != 1 || trashed[0].ID != "2" {
+		t.Errorf("Expected only todo 2 left, got %v", trashed)
+	}
+}
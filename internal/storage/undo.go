@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// undoFile holds the undo/redo history so undo survives a restart
+const undoFile = "undo.json"
+
+// LoadUndoLog loads the undo/redo history from undo.json
+// Returns an empty log if the file doesn't exist yet
+func LoadUndoLog() (models.UndoLog, error) {
+	dir, err := GetAmosDir()
+	if err != nil {
+		return models.UndoLog{}, err
+	}
+
+	path := filepath.Join(dir, undoFile)
+
+	// If file doesn't exist, return empty log
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return models.UndoLog{}, nil
+	}
+
+	data, err := readDataFile(path)
+	if err != nil {
+		return models.UndoLog{}, err
+	}
+
+	var log models.UndoLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return models.UndoLog{}, err
+	}
+
+	return log, nil
+}
+
+// SaveUndoLog saves the undo/redo history to undo.json
+func SaveUndoLog(log models.UndoLog) error {
+	return withLock(func() error { return saveUndoLog(log) })
+}
+
+// saveUndoLog is SaveUndoLog without taking the storage lock
+func saveUndoLog(log models.UndoLog) error {
+	if err := EnsureAmosDir(); err != nil {
+		return err
+	}
+
+	dir, err := GetAmosDir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, undoFile)
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeDataFile(path, data)
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+func TestSaveAndLoadUndoLog(t *testing.T) {
+	// Use temp directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	empty, err := LoadUndoLog()
+	if err != nil {
+		t.Fatalf("LoadUndoLog() on missing file failed: %v", err)
+	}
+	if len(empty.Undo) != 0 || len(empty.Redo) != 0 {
+		t.Errorf("Expected empty log, got %+v", empty)
+	}
+
+	before := models.Todo{ID: "1", Title: "Task", Status: "open"}
+	after := models.Todo{ID: "1", Title: "Task", Status: "next"}
+	log := models.UndoLog{
+		Undo: []models.UndoRecord{{
+			Label: "status → next",
+			At:    time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+			Todos: []models.TodoChange{{ID: "1", Before: &before, After: &after}},
+		}},
+	}
+	if err := SaveUndoLog(log); err != nil {
+		t.Fatalf("SaveUndoLog() failed: %v", err)
+	}
+
+	loaded, err := LoadUndoLog()
+	if err != nil {
+		t.Fatalf("LoadUndoLog() failed: %v", err)
+	}
+	if len(loaded.Undo) != 1 || loaded.Undo[0].Label != "status → next" {
+		t.Fatalf("Unexpected log after round trip: %+v", loaded)
+	}
+	change := loaded.Undo[0].Todos[0]
+	if change.Before == nil || change.Before.Status != "open" || change.After == nil || change.After.Status != "next" {
+		t.Errorf("Unexpected change after round trip: %+v", change)
+	}
+}
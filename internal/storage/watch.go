@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// pollInterval is how often the polling watcher re-reads the data files
+const pollInterval = time.Second
+
+// ownWrites remembers the contents this process last wrote to each file (path -> hash),
+// so the watcher can tell the TUI's own saves from changes made by other processes
+var ownWrites = struct {
+	sync.Mutex
+	sums map[string][sha256.Size]byte
+}{sums: map[string][sha256.Size]byte{}}
+
+// rememberWrite records data as this process's latest write to path
+func rememberWrite(path string, data []byte) {
+	ownWrites.Lock()
+	defer ownWrites.Unlock()
+	ownWrites.sums[path] = sha256.Sum256(data)
+}
+
+// isOwnWrite reports whether sum is what this process last wrote to path
+func isOwnWrite(path string, sum [sha256.Size]byte) bool {
+	ownWrites.Lock()
+	defer ownWrites.Unlock()
+	own, ok := ownWrites.sums[path]
+	return ok && own == sum
+}
+
+// Change says which data files another process modified
+type Change struct {
+	Entries bool // entries.json changed
+	Todos   bool // todos.json changed
+}
+
+// Watcher reports changes to entries.json and todos.json made outside this process
+// (a script, "amos serve", a second instance or a sync tool)
+// Uses inotify on Linux and falls back to polling elsewhere
+type Watcher struct {
+	dir     string
+	changes chan Change // Buffered (1): pending changes are merged, never queued
+	done    chan struct{}
+	once    sync.Once
+	seen    map[string][sha256.Size]byte // Last contents seen per file (owned by the watch goroutine)
+}
+
+// NewWatcher starts watching the data directory
+func NewWatcher() (*Watcher, error) {
+	w, err := newWatcher()
+	if err != nil {
+		return nil, err
+	}
+	go w.run()
+	return w, nil
+}
+
+// newWatcher sets up a watcher without starting it
+func newWatcher() (*Watcher, error) {
+	if err := EnsureAmosDir(); err != nil {
+		return nil, err
+	}
+	dir, err := GetAmosDir()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		dir:     dir,
+		changes: make(chan Change, 1),
+		done:    make(chan struct{}),
+		seen:    map[string][sha256.Size]byte{},
+	}
+	for _, name := range []string{entriesFile, todosFile} {
+		w.seen[name] = hashFile(filepath.Join(dir, name))
+	}
+	return w, nil
+}
+
+// Changes delivers external changes (closed by Close)
+func (w *Watcher) Changes() <-chan Change {
+	return w.changes
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.once.Do(func() { close(w.done) })
+}
+
+// poll re-reads the data files every interval until Close
+func (w *Watcher) poll(interval time.Duration) {
+	defer close(w.changes)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.check(entriesFile)
+			w.check(todosFile)
+		}
+	}
+}
+
+// check compares a data file with the contents last seen and reports an external change
+// Rewrites with the same contents and this process's own saves are ignored, and so is a file
+// another process is still writing: it is checked again on the next event or poll
+func (w *Watcher) check(name string) {
+	if name != entriesFile && name != todosFile {
+		return
+	}
+
+	path := filepath.Join(w.dir, name)
+	sum, complete := completeHash(path)
+	if !complete || sum == w.seen[name] {
+		return
+	}
+	w.seen[name] = sum
+	if isOwnWrite(path, sum) {
+		return
+	}
+
+	change := Change{Entries: name == entriesFile, Todos: name == todosFile}
+
+	// Merge with a change the TUI hasn't picked up yet (we're the only sender, so this never blocks)
+	select {
+	case pending := <-w.changes:
+		change.Entries = change.Entries || pending.Entries
+		change.Todos = change.Todos || pending.Todos
+	default:
+	}
+	w.changes <- change
+}
+
+// completeHash hashes a data file like hashFile, reporting false while its contents are cut off
+// (a non-atomic write in progress: JSON or ciphertext that doesn't decode yet); a missing file is complete
+func completeHash(path string) ([sha256.Size]byte, bool) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return [sha256.Size]byte{}, true
+	} else if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	plain, err := decryptData(filepath.Dir(path), data)
+	if err != nil || !json.Valid(plain) {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256.Sum256(data), true
+}
+
+// hashFile returns the SHA-256 of a file's contents (zero for a missing file)
+func hashFile(path string) [sha256.Size]byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return [sha256.Size]byte{}
+	}
+	return sha256.Sum256(data)
+}
@@ -0,0 +1,61 @@
+//go:build linux
+
+package storage
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// run watches the data directory with inotify (polling if inotify is unavailable)
+// Atomic saves show up as IN_MOVED_TO, in-place writes as IN_CLOSE_WRITE
+func (w *Watcher) run() {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		w.poll(pollInterval)
+		return
+	}
+	mask := uint32(syscall.IN_CLOSE_WRITE | syscall.IN_MOVED_TO | syscall.IN_CREATE | syscall.IN_DELETE)
+	if _, err := syscall.InotifyAddWatch(fd, w.dir, mask); err != nil {
+		syscall.Close(fd)
+		w.poll(pollInterval)
+		return
+	}
+
+	// A non-blocking fd goes through the runtime poller, so Close unblocks Read
+	f := os.NewFile(uintptr(fd), "inotify")
+	go func() {
+		<-w.done
+		f.Close()
+	}()
+	defer close(w.changes)
+
+	buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+	for {
+		n, err := f.Read(buf)
+		if err != nil {
+			return
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			nameEnd := nameStart + int(event.Len)
+			if nameEnd > n {
+				break
+			}
+
+			// Names are NUL-padded
+			name := buf[nameStart:nameEnd]
+			for i, b := range name {
+				if b == 0 {
+					name = name[:i]
+					break
+				}
+			}
+			w.check(string(name))
+			offset = nameEnd
+		}
+	}
+}
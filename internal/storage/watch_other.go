@@ -0,0 +1,8 @@
+//go:build !linux
+
+package storage
+
+// run polls the data files (no inotify outside Linux)
+func (w *Watcher) run() {
+	w.poll(pollInterval)
+}
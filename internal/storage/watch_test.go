@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// waitForChange returns the next change, or false after timeout
+func waitForChange(w *Watcher, timeout time.Duration) (Change, bool) {
+	select {
+	case change := <-w.Changes():
+		return change, true
+	case <-time.After(timeout):
+		return Change{}, false
+	}
+}
+
+func testWatcher(t *testing.T, start func(w *Watcher)) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	if err := SaveTodo(models.Todo{ID: "1", Title: "Existing", Status: "open"}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+
+	w, err := newWatcher()
+	if err != nil {
+		t.Fatalf("newWatcher() failed: %v", err)
+	}
+	defer w.Close()
+	start(w)
+
+	// Our own saves are not reported
+	if err := SaveTodo(models.Todo{ID: "2", Title: "Own save", Status: "open"}); err != nil {
+		t.Fatalf("SaveTodo() failed: %v", err)
+	}
+	if change, ok := waitForChange(w, 300*time.Millisecond); ok {
+		t.Fatalf("Expected no change for our own save, got %+v", change)
+	}
+
+	// Another process half-way through rewriting todos.json in place is not reported yet
+	path := filepath.Join(tempDir, amosDir, todosFile)
+	content := []byte(`[{"id":"3","title":"External","status":"open","tags":[]}]`)
+	if err := os.WriteFile(path, content[:20], 0644); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+	if change, ok := waitForChange(w, 300*time.Millisecond); ok {
+		t.Fatalf("Expected no change for a half-written file, got %+v", change)
+	}
+
+	// Once the write completes, it is
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+	change, ok := waitForChange(w, 3*time.Second)
+	if !ok {
+		t.Fatal("Expected a change for an external write")
+	}
+	if !change.Todos || change.Entries {
+		t.Errorf("Expected only todos changed, got %+v", change)
+	}
+
+	// Files we don't keep in memory are ignored
+	if err := os.WriteFile(filepath.Join(tempDir, amosDir, "archive.json"), []byte(`[]`), 0644); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+	if change, ok := waitForChange(w, 300*time.Millisecond); ok {
+		t.Errorf("Expected no change for archive.json, got %+v", change)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	testWatcher(t, func(w *Watcher) { go w.run() })
+}
+
+func TestWatcherPolling(t *testing.T) {
+	testWatcher(t, func(w *Watcher) { go w.poll(20 * time.Millisecond) })
+}
+
+func TestWatcherClose(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	w, err := NewWatcher()
+	if err != nil {
+		t.Fatalf("NewWatcher() failed: %v", err)
+	}
+	w.Close()
+	w.Close() // Safe to call twice
+
+	select {
+	case _, ok := <-w.Changes():
+		if ok {
+			t.Error("Expected Changes() to be closed")
+		}
+	case <-time.After(3 * time.Second):
+		t.Error("Expected Changes() to close after Close()")
+	}
+}
@@ -1,14 +1,46 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/apodacaa/amos/internal/storage"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 func main() {
+	// Subcommands (e.g. "amos review") run without the TUI
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		os.Exit(runCommand(os.Args[1:]))
+	}
+
+	startView, err := parseStartFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
 	m := NewModel()
+	m.view = startView
+
+	// An encrypted journal asks for the passphrase first
+	if storage.IsEncrypted() {
+		m.unlockView = startView
+		m.view = "unlock"
+		m.passphraseInput.Focus()
+	}
+
+	// Live reload when another process changes the data files (the TUI still works without it)
+	if watcher, err := storage.NewWatcher(); err == nil {
+		m.watcher = watcher
+		defer watcher.Close()
+	}
+
 	p := tea.NewProgram(m, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -1,22 +1,35 @@
 package main
 
-import "github.com/apodacaa/amos/internal/models"
+import (
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/internal/storage"
+)
 
 // saveCompleteMsg is sent when save operation completes
 type saveCompleteMsg struct {
-	err error
+	err  error
+	undo *models.UndoRecord // How to revert the save (nil if nothing to record)
 }
 
 // entriesLoadedMsg is sent when entries are loaded
 type entriesLoadedMsg struct {
-	entries []models.Entry
-	err     error
+	entries  []models.Entry
+	external bool // Reloaded because another process changed entries.json
+	err      error
 }
 
 // todosLoadedMsg is sent when todos are loaded
 type todosLoadedMsg struct {
-	todos []models.Todo
-	err   error
+	todos        []models.Todo
+	autoArchived int  // Done todos moved to archive.json before loading
+	purged       int  // Old todos removed from trash.json before loading
+	external     bool // Reloaded because another process changed todos.json
+	err          error
+}
+
+// dataChangedMsg is sent when another process changed entries.json or todos.json
+type dataChangedMsg struct {
+	change storage.Change
 }
 
 // todoToggledMsg is sent when todo status is toggled
@@ -26,3 +39,108 @@ type todoToggledMsg struct {
 
 // statusTimeoutMsg is sent when status message should be cleared
 type statusTimeoutMsg struct{}
+
+// configLoadedMsg is sent when user settings are loaded
+type configLoadedMsg struct {
+	config models.Config
+	err    error
+}
+
+// tagRenamedMsg is sent when a tag rename/merge has been written to storage
+type tagRenamedMsg struct {
+	oldTag         string
+	newTag         string
+	merged         bool // newTag already existed before the rename
+	entriesChanged int
+	todosChanged   int
+	err            error
+}
+
+// timesheetSavedMsg is sent when a timesheet has been exported as CSV
+type timesheetSavedMsg struct {
+	path string
+	err  error
+}
+
+// focusTickMsg advances the focus mode countdown (session tells stale ticks apart)
+type focusTickMsg struct {
+	session int
+}
+
+// timerTickMsg redraws the running timer in the header
+type timerTickMsg struct{}
+
+// reviewSavedMsg is sent when a weekly review has been exported as Markdown
+type reviewSavedMsg struct {
+	path string
+	err  error
+}
+
+// bulkAppliedMsg is sent when a bulk action on marked todos has been written to storage
+type bulkAppliedMsg struct {
+	summary string
+	undo    *models.UndoRecord // How to revert the bulk action
+	err     error
+}
+
+// undoLogLoadedMsg is sent when the persisted undo/redo history is loaded
+type undoLogLoadedMsg struct {
+	log models.UndoLog
+	err error
+}
+
+// undoLogSavedMsg is sent when the undo/redo history has been written
+type undoLogSavedMsg struct {
+	err error
+}
+
+// undoAppliedMsg is sent when an undo (or redo) has been written to storage
+type undoAppliedMsg struct {
+	record models.UndoRecord
+	undo   bool // true = undo, false = redo
+	err    error
+}
+
+// archiveLoadedMsg is sent when archived todos are loaded from archive.json
+type archiveLoadedMsg struct {
+	todos []models.Todo
+	err   error
+}
+
+// trashLoadedMsg is sent when deleted todos are loaded from trash.json
+type trashLoadedMsg struct {
+	todos []models.Todo
+	err   error
+}
+
+// todosRestoredMsg is sent after todos are moved from archive.json (or trash.json) back to todos.json
+type todosRestoredMsg struct {
+	restored  int
+	fromTrash bool // Restored from trash.json instead of archive.json
+	undo      *models.UndoRecord
+	err       error
+}
+
+// unlockedMsg is sent when the key has been derived from the entered passphrase
+type unlockedMsg struct {
+	err error
+}
+
+// integrityCheckedMsg is sent when the data files have been checked for problems (amos fsck)
+type integrityCheckedMsg struct {
+	problems int
+	err      error
+}
+
+// backupsLoadedMsg is sent when the backup snapshots (and what each holds) have been listed
+type backupsLoadedMsg struct {
+	snapshots []storage.Snapshot
+	counts    map[string]storage.SnapshotCounts // By snapshot name; missing = unreadable (e.g. older passphrase)
+	err       error
+}
+
+// backupRestoredMsg is sent when a snapshot has been restored over the data files
+type backupRestoredMsg struct {
+	name string
+	err  error
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/apodacaa/amos/internal/helpers"
 	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/internal/storage"
 	"github.com/apodacaa/amos/ui"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/google/uuid"
@@ -15,31 +18,79 @@ import (
 
 // Model holds the application state
 type Model struct {
-	view               string         // Current view: "dashboard", "entry", "entries", "view_entry", "todos", "unified_filter", or "add_todo"
-	width              int            // Terminal width
-	height             int            // Terminal height
-	textarea           textarea.Model // Textarea for entry input
-	todoInput          textarea.Model // Single-line input for standalone todos
-	unifiedFilterInput textarea.Model // Single-line input for unified filtering (tags + dates)
-	currentEntry       models.Entry   // Entry being edited
-	currentTodo        models.Todo    // Standalone todo being created
-	viewingEntry       models.Entry   // Entry being viewed (read-only)
-	scrollOffset       int            // Scroll offset for long entry view
-	statusMsg          string         // Status message to display
-	statusTime         time.Time      // When status message was set
-	hasUnsaved         bool           // Whether there are unsaved changes
-	savedContent       string         // Last saved content (to detect changes)
-	confirmingExit     bool           // Whether showing exit confirmation
-	entries            []models.Entry // All entries (for list view)
-	selectedEntry      int            // Selected entry index in list
-	todos              []models.Todo  // All todos (raw, unsorted)
-	displayTodos       []models.Todo  // Sorted todos for display (only updated on load/refresh)
-	selectedTodo       int            // Selected todo index in list
-	filterTags         []string       // Current tag filters (empty = no filter), supports multiple tags with AND logic
-	filterContext      string         // Context for filtering: "entries" or "todos" (which view to return to)
-	filterDate         string         // Current date filter preset (empty = no filter)
-	availableTags      []string       // All unique tags across entries
-	autocompleteTag    string         // Current autocomplete suggestion for tag input
+	view               string                            // Current view: "dashboard", "entry", "entries", "view_entry", "todos", "unified_filter", "add_todo", "tags", "people", "stats", "review", "board", "agenda", "bulk_todos", "todo_detail", "archive", "trash", "timesheet", "focus", "backups" or "unlock"
+	width              int                               // Terminal width
+	height             int                               // Terminal height
+	textarea           textarea.Model                    // Textarea for entry input
+	todoInput          textarea.Model                    // Single-line input for standalone todos
+	unifiedFilterInput textarea.Model                    // Single-line input for unified filtering (tags + dates)
+	currentEntry       models.Entry                      // Entry being edited
+	currentTodo        models.Todo                       // Standalone todo being created
+	viewingEntry       models.Entry                      // Entry being viewed (read-only)
+	scrollOffset       int                               // Scroll offset for long entry view
+	statusMsg          string                            // Status message to display
+	statusTime         time.Time                         // When status message was set
+	hasUnsaved         bool                              // Whether there are unsaved changes
+	savedContent       string                            // Last saved content (to detect changes)
+	confirmingExit     bool                              // Whether showing exit confirmation
+	entries            []models.Entry                    // All entries (for list view)
+	selectedEntry      int                               // Selected entry index in list
+	todos              []models.Todo                     // All todos (raw, unsorted)
+	displayTodos       []models.Todo                     // Sorted todos for display (only updated on load/refresh)
+	selectedTodo       int                               // Selected todo index in list
+	archivedTodos      []models.Todo                     // Archived todos, most recently archived first (archive.json, loaded on demand)
+	selectedArchived   int                               // Selected todo index in the archive browser
+	trashedTodos       []models.Todo                     // Deleted todos, most recently deleted first (trash.json, loaded on demand)
+	selectedTrashed    int                               // Selected todo index in the trash browser
+	filterTags         []string                          // Current tag filters (empty = no filter), supports multiple tags with AND logic
+	filterPeople       []string                          // Current person filters (e.g. "+alice"), AND logic like tags
+	filterText         []string                          // Current free-text filters from "quoted phrases" (titles, bodies, notes), AND logic
+	filterContext      string                            // Context for filtering: "entries", "todos", "board", "archive" or "trash" (which view to return to)
+	filterDate         string                            // Current date filter preset (empty = no filter)
+	availableTags      []helpers.TagNode                 // Tag tree (with counts) across entries and todos
+	availablePeople    []string                          // All unique +people across entries and todos
+	autocompleteTag    string                            // Current autocomplete suggestion for tag input
+	selectedTag        int                               // Selected tag index in tag management list
+	selectedPerson     int                               // Selected person index in people view
+	renamingTag        string                            // Tag being renamed/merged (empty = not renaming)
+	tagInput           textarea.Model                    // Single-line input for the rename/merge target
+	config             models.Config                     // User settings (tag aliases, etc.)
+	dashboardMode      string                            // Dashboard activity section: "graph" or "heatmap"
+	statsWeeks         int                               // Stats view window in weeks (4, 13 or 52)
+	reviewYear         int                               // ISO year shown in the weekly review
+	reviewWeek         int                               // ISO week shown in the weekly review
+	timesheetYear      int                               // ISO year shown in the timesheet
+	timesheetWeek      int                               // ISO week shown in the timesheet
+	timerTicking       bool                              // Whether a timerTick is scheduled (one tick loop while a timer runs)
+	focusTodoID        string                            // ID of the todo focus mode is bound to
+	focusPhase         string                            // Focus mode interval: "work" or "break"
+	focusEnds          time.Time                         // When the current focus interval ends
+	focusSession       int                               // Incremented on each start/leave so stale focus ticks are ignored
+	focusReturnView    string                            // View to return to when leaving focus mode
+	boardColumn        int                               // Focused column on the todo board
+	boardRows          map[string]int                    // Selected card per status column on the todo board
+	selectedAgenda     int                               // Selected todo index in the agenda (sections flattened)
+	undoLog            models.UndoLog                    // Undo/redo history (persisted to undo.json)
+	markedTodos        map[string]bool                   // Todo IDs marked for a bulk action in the todos list
+	markAnchor         int                               // Todo list index of the last mark (start of an M range), -1 = none
+	bulkInput          textarea.Model                    // Single-line input for bulk commands (done, @tag, due fri, archive)
+	detailTodoID       string                            // ID of the todo shown in the detail view
+	detailField        int                               // Selected field in the todo detail view (index into ui.TodoDetailFields)
+	detailEditing      bool                              // Whether the selected detail field is being edited
+	detailInput        textarea.Model                    // Single-line input for editing a todo's title or tags
+	notesInput         textarea.Model                    // Multi-line input for editing a todo's notes
+	watcher            *storage.Watcher                  // Reports changes other processes make to the data files (nil = no live reload)
+	passphraseInput    textinput.Model                   // Masked input for the passphrase of an encrypted journal
+	unlocking          bool                              // Whether the key is being derived from the entered passphrase
+	unlockView         string                            // View to open once the journal is unlocked
+	revealPrivate      bool                              // Whether private (@private) entries are shown (toggled with p)
+	snapshots          []storage.Snapshot                // Backup snapshots, newest first (loaded by the backups view)
+	snapshotCounts     map[string]storage.SnapshotCounts // What each snapshot holds, by name (missing = unreadable)
+	selectedSnapshot   int                               // Selected snapshot index in the backups view
+	confirmingRestore  string                            // Snapshot waiting for a second R to be restored (empty = none)
+	integrityProblems  int                               // Problems amos fsck would report, found at load (dashboard badge)
+	entryList          *helpers.EntryList                // Filtered, sorted entries for the entry list and view (rebuilt on data or filter change)
+	todoList           *helpers.TodoList                 // Filtered displayTodos for the todo list and board (rebuilt on data or filter change)
 }
 
 // NewModel creates a new model with default values
@@ -91,6 +142,74 @@ func NewModel() Model {
 	unifiedFilterInput.FocusedStyle.Text = ui.GetTextStyle()
 	unifiedFilterInput.BlurredStyle.Text = ui.GetTextStyle()
 
+	// Create single-line input for renaming/merging tags
+	tagInput := textarea.New()
+	tagInput.Placeholder = "new tag name (existing tag = merge)"
+	tagInput.CharLimit = 0
+	tagInput.SetWidth(60)
+	tagInput.SetHeight(1) // Single line
+	tagInput.FocusedStyle.CursorLine = ui.GetTextareaStyle()
+	tagInput.BlurredStyle.CursorLine = ui.GetTextareaStyle()
+	tagInput.FocusedStyle.Placeholder = ui.GetPlaceholderStyle()
+	tagInput.BlurredStyle.Placeholder = ui.GetPlaceholderStyle()
+	tagInput.FocusedStyle.Prompt = ui.GetPromptStyle()
+	tagInput.BlurredStyle.Prompt = ui.GetPromptStyle()
+	tagInput.FocusedStyle.Text = ui.GetTextStyle()
+	tagInput.BlurredStyle.Text = ui.GetTextStyle()
+
+	// Create single-line input for bulk commands on marked todos
+	bulkInput := textarea.New()
+	bulkInput.Placeholder = "done, @tag, -@tag, due fri, archive..."
+	bulkInput.CharLimit = 0
+	bulkInput.SetWidth(60)
+	bulkInput.SetHeight(1) // Single line
+	bulkInput.FocusedStyle.CursorLine = ui.GetTextareaStyle()
+	bulkInput.BlurredStyle.CursorLine = ui.GetTextareaStyle()
+	bulkInput.FocusedStyle.Placeholder = ui.GetPlaceholderStyle()
+	bulkInput.BlurredStyle.Placeholder = ui.GetPlaceholderStyle()
+	bulkInput.FocusedStyle.Prompt = ui.GetPromptStyle()
+	bulkInput.BlurredStyle.Prompt = ui.GetPromptStyle()
+	bulkInput.FocusedStyle.Text = ui.GetTextStyle()
+	bulkInput.BlurredStyle.Text = ui.GetTextStyle()
+
+	// Create single-line input for editing a todo's title or tags (detail view)
+	detailInput := textarea.New()
+	detailInput.CharLimit = 0
+	detailInput.SetWidth(60)
+	detailInput.SetHeight(1) // Single line
+	detailInput.FocusedStyle.CursorLine = ui.GetTextareaStyle()
+	detailInput.BlurredStyle.CursorLine = ui.GetTextareaStyle()
+	detailInput.FocusedStyle.Placeholder = ui.GetPlaceholderStyle()
+	detailInput.BlurredStyle.Placeholder = ui.GetPlaceholderStyle()
+	detailInput.FocusedStyle.Prompt = ui.GetPromptStyle()
+	detailInput.BlurredStyle.Prompt = ui.GetPromptStyle()
+	detailInput.FocusedStyle.Text = ui.GetTextStyle()
+	detailInput.BlurredStyle.Text = ui.GetTextStyle()
+
+	// Create multi-line input for todo notes (detail view)
+	notesInput := textarea.New()
+	notesInput.Placeholder = "Links, commands, acceptance criteria...\n\n- [ ] checklist item"
+	notesInput.CharLimit = 0 // No limit
+	notesInput.SetWidth(60)
+	notesInput.SetHeight(8)
+	notesInput.FocusedStyle.CursorLine = ui.GetTextareaStyle()
+	notesInput.BlurredStyle.CursorLine = ui.GetTextareaStyle()
+	notesInput.FocusedStyle.Placeholder = ui.GetPlaceholderStyle()
+	notesInput.BlurredStyle.Placeholder = ui.GetPlaceholderStyle()
+	notesInput.FocusedStyle.Prompt = ui.GetPromptStyle()
+	notesInput.BlurredStyle.Prompt = ui.GetPromptStyle()
+	notesInput.FocusedStyle.Text = ui.GetTextStyle()
+	notesInput.BlurredStyle.Text = ui.GetTextStyle()
+
+	// Create masked input for unlocking an encrypted journal
+	passphraseInput := textinput.New()
+	passphraseInput.EchoMode = textinput.EchoPassword
+	passphraseInput.EchoCharacter = '•'
+	passphraseInput.Placeholder = "passphrase"
+	passphraseInput.PromptStyle = ui.GetPromptStyle()
+	passphraseInput.TextStyle = ui.GetTextStyle()
+	passphraseInput.PlaceholderStyle = ui.GetPlaceholderStyle()
+
 	return Model{
 		view:               "dashboard",
 		width:              80, // Default width
@@ -98,13 +217,37 @@ func NewModel() Model {
 		textarea:           ta,
 		todoInput:          todoInput,
 		unifiedFilterInput: unifiedFilterInput,
+		tagInput:           tagInput,
+		bulkInput:          bulkInput,
+		detailInput:        detailInput,
+		notesInput:         notesInput,
+		passphraseInput:    passphraseInput,
+		dashboardMode:      "graph",
+		statsWeeks:         13,
+		boardRows:          map[string]int{},
+		markedTodos:        map[string]bool{},
+		markAnchor:         -1,
+		entryList:          &helpers.EntryList{},
+		todoList:           &helpers.TodoList{},
 	}
 }
 
 // Init initializes the model (Elm architecture)
 func (m Model) Init() tea.Cmd {
-	// Load entries and todos on startup
-	return tea.Batch(textarea.Blink, m.loadEntriesAndTodos())
+	// An encrypted journal is loaded once the passphrase is entered
+	if m.view == "unlock" {
+		return textinput.Blink
+	}
+	return m.loadJournal()
+}
+
+// loadJournal loads settings, undo history, entries and todos and starts watching for changes
+func (m Model) loadJournal() tea.Cmd {
+	cmds := []tea.Cmd{textarea.Blink, m.loadConfig(), m.loadUndoLog(), m.loadEntriesAndTodos(), m.checkIntegrity()}
+	if m.watcher != nil {
+		cmds = append(cmds, waitForDataChange(m.watcher))
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles messages (Elm architecture)
@@ -127,6 +270,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleUnifiedFilterKeys(msg)
 		case "add_todo":
 			return m.handleAddTodoKeys(msg)
+		case "tags":
+			return m.handleTagsKeys(msg)
+		case "people":
+			return m.handlePeopleKeys(msg)
+		case "stats":
+			return m.handleStatsKeys(msg)
+		case "review":
+			return m.handleReviewKeys(msg)
+		case "board":
+			return m.handleBoardKeys(msg)
+		case "agenda":
+			return m.handleAgendaKeys(msg)
+		case "bulk_todos":
+			return m.handleBulkKeys(msg)
+		case "todo_detail":
+			return m.handleTodoDetailKeys(msg)
+		case "archive":
+			return m.handleArchiveKeys(msg)
+		case "trash":
+			return m.handleTrashKeys(msg)
+		case "timesheet":
+			return m.handleTimesheetKeys(msg)
+		case "focus":
+			return m.handleFocusKeys(msg)
+		case "backups":
+			return m.handleBackupsKeys(msg)
+		case "unlock":
+			return m.handleUnlockKeys(msg)
 		default:
 			return m.handleKeyPress(msg)
 		}
@@ -140,6 +311,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.textarea.SetWidth(msg.Width - 10)
 			m.textarea.SetHeight(msg.Height - 12)
 		}
+		// Notes editor sits below the todo's fields and metadata
+		if msg.Width > 10 && msg.Height > 20 {
+			m.notesInput.SetWidth(msg.Width - 10)
+			m.notesInput.SetHeight(msg.Height - 20)
+		}
 		return m, nil
 
 	case saveCompleteMsg:
@@ -156,11 +332,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// For add_todo, we stay in the form (user can add another or press Esc)
 		}
 		m.statusTime = time.Now()
+		if msg.err == nil && msg.undo != nil {
+			m.undoLog = helpers.PushUndo(m.undoLog, *msg.undo)
+			return m, tea.Batch(saveUndoLog(m.undoLog), clearStatusAfterDelay())
+		}
 		return m, clearStatusAfterDelay()
 
+	case dataChangedMsg:
+		// Keep waiting for the next change
+		return m, tea.Batch(reloadChangedData(msg.change), waitForDataChange(m.watcher))
+
 	case entriesLoadedMsg:
 		if msg.err != nil {
 			m.statusMsg = "Error loading entries: " + msg.err.Error()
+		} else if msg.external {
+			return m.applyExternalEntries(msg.entries)
 		} else {
 			m.entries = msg.entries
 			m.selectedEntry = 0
@@ -170,6 +356,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case todosLoadedMsg:
 		if msg.err != nil {
 			m.statusMsg = "Error loading todos: " + msg.err.Error()
+		} else if msg.external {
+			return m.applyExternalTodos(msg.todos)
 		} else {
 			m.todos = msg.todos
 			// Update display order (sort for display)
@@ -179,9 +367,205 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.selectedTodo >= len(m.displayTodos) {
 				m.selectedTodo = 0
 			}
+
+			var notices []string
+			if msg.autoArchived > 0 {
+				notices = append(notices, fmt.Sprintf("archived %d done todos", msg.autoArchived))
+			}
+			if msg.purged > 0 {
+				notices = append(notices, fmt.Sprintf("purged %d todos from trash", msg.purged))
+			}
+			var cmds []tea.Cmd
+			if len(notices) > 0 {
+				m.statusMsg = strings.Join(notices, ", ")
+				m.statusTime = time.Now()
+				cmds = append(cmds, clearStatusAfterDelay())
+			}
+
+			// A timer left running (e.g. before a restart) keeps ticking in the header
+			if _, running := helpers.RunningTimer(m.todos); running && !m.timerTicking {
+				m.timerTicking = true
+				cmds = append(cmds, timerTick())
+			}
+			return m, tea.Batch(cmds...)
+		}
+		return m, nil
+
+	case integrityCheckedMsg:
+		// Only the badge: a load error is already reported by the regular loads
+		if msg.err == nil {
+			m.integrityProblems = msg.problems
+		}
+		return m, nil
+
+	case backupsLoadedMsg:
+		return m.handleBackupsLoaded(msg)
+
+	case backupRestoredMsg:
+		return m.handleBackupRestored(msg)
+
+	case unlockedMsg:
+		return m.handleUnlocked(msg)
+
+	case focusTickMsg:
+		return m.handleFocusTick(msg)
+
+	case timerTickMsg:
+		// Keep ticking only while a timer runs
+		if _, running := helpers.RunningTimer(m.todos); running {
+			return m, timerTick()
+		}
+		m.timerTicking = false
+		return m, nil
+
+	case archiveLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error loading archive: " + msg.err.Error()
+			m.statusTime = time.Now()
+		} else {
+			m.archivedTodos = helpers.SortArchivedTodos(msg.todos)
+			if m.selectedArchived >= len(m.archivedTodos) {
+				m.selectedArchived = 0
+			}
+		}
+		return m, nil
+
+	case trashLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error loading trash: " + msg.err.Error()
+			m.statusTime = time.Now()
+		} else {
+			m.trashedTodos = helpers.SortTrashedTodos(msg.todos)
+			if m.selectedTrashed >= len(m.trashedTodos) {
+				m.selectedTrashed = 0
+			}
+		}
+		return m, nil
+
+	case todosRestoredMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error restoring: " + msg.err.Error()
+		} else {
+			m.statusMsg = fmt.Sprintf("restored %d todos", msg.restored)
+		}
+		m.statusTime = time.Now()
+		cmds := []tea.Cmd{m.loadTodos(), clearStatusAfterDelay()}
+		if msg.fromTrash {
+			cmds = append(cmds, m.loadTrash())
+		} else {
+			cmds = append(cmds, m.loadArchive())
+		}
+		if msg.err == nil && msg.undo != nil {
+			m.undoLog = helpers.PushUndo(m.undoLog, *msg.undo)
+			cmds = append(cmds, saveUndoLog(m.undoLog))
+		}
+		return m, tea.Batch(cmds...)
+
+	case configLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error loading config: " + msg.err.Error()
+			m.statusTime = time.Now()
+		} else {
+			m.config = msg.config
+		}
+		return m, nil
+
+	case timesheetSavedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error exporting timesheet: " + msg.err.Error()
+		} else {
+			m.statusMsg = "exported " + msg.path
+		}
+		m.statusTime = time.Now()
+		return m, clearStatusAfterDelay()
+
+	case reviewSavedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error exporting review: " + msg.err.Error()
+		} else {
+			m.statusMsg = "exported " + msg.path
+		}
+		m.statusTime = time.Now()
+		return m, clearStatusAfterDelay()
+
+	case bulkAppliedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error applying bulk action: " + msg.err.Error()
+		} else {
+			m.statusMsg = msg.summary
+			m.markedTodos = map[string]bool{}
+			m.markAnchor = -1
+		}
+		m.statusTime = time.Now()
+		// Reload and re-sort so the list reflects the new state
+		cmds := []tea.Cmd{m.loadTodos(), clearStatusAfterDelay()}
+		if msg.err == nil && msg.undo != nil {
+			m.undoLog = helpers.PushUndo(m.undoLog, *msg.undo)
+			cmds = append(cmds, saveUndoLog(m.undoLog))
+		}
+		return m, tea.Batch(cmds...)
+
+	case undoLogLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error loading undo history: " + msg.err.Error()
+			m.statusTime = time.Now()
+		} else {
+			m.undoLog = msg.log
 		}
 		return m, nil
 
+	case undoLogSavedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error saving undo history: " + msg.err.Error()
+			m.statusTime = time.Now()
+			return m, clearStatusAfterDelay()
+		}
+		return m, nil
+
+	case undoAppliedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error: " + msg.err.Error()
+			m.statusTime = time.Now()
+			return m, clearStatusAfterDelay()
+		}
+
+		// Move the record between stacks (only if it is still on top: repeated keypresses
+		// can deliver the same record twice, and applying it again was a no-op)
+		stack := m.undoLog.Redo
+		verb := "redid"
+		if msg.undo {
+			stack = m.undoLog.Undo
+			verb = "undid"
+		}
+		if len(stack) > 0 && stack[len(stack)-1].At.Equal(msg.record.At) && stack[len(stack)-1].Label == msg.record.Label {
+			m.undoLog = helpers.MoveUndoRecord(m.undoLog, msg.undo)
+		}
+
+		m.statusMsg = verb + ": " + msg.record.Label
+		m.statusTime = time.Now()
+		cmds := []tea.Cmd{saveUndoLog(m.undoLog), m.loadEntriesAndTodos(), clearStatusAfterDelay()}
+		if helpers.RecordTouchesArchive(msg.record) {
+			cmds = append(cmds, m.loadArchive())
+		}
+		if helpers.RecordTouchesTrash(msg.record) {
+			cmds = append(cmds, m.loadTrash())
+		}
+		return m, tea.Batch(cmds...)
+
+	case tagRenamedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Error renaming tag: " + msg.err.Error()
+		} else {
+			verb := "renamed"
+			if msg.merged {
+				verb = "merged"
+			}
+			m.statusMsg = fmt.Sprintf("%s @%s → @%s (%d entries, %d todos)", verb, msg.oldTag, msg.newTag, msg.entriesChanged, msg.todosChanged)
+		}
+		m.statusTime = time.Now()
+		// Reload everything so lists and the alias table reflect the rewrite
+		return m, tea.Batch(m.loadConfig(), m.loadEntriesAndTodos(), m.loadArchive(), m.loadTrash(), clearStatusAfterDelay())
+
 	case todoToggledMsg:
 		if msg.err != nil {
 			m.statusMsg = "Error saving todo: " + msg.err.Error()
@@ -202,6 +586,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.view == "entry" {
 		m.textarea, cmd = m.textarea.Update(msg)
 	}
+	// Cursor blink of the passphrase input
+	if m.view == "unlock" {
+		m.passphraseInput, cmd = m.passphraseInput.Update(msg)
+	}
 
 	return m, cmd
 }
@@ -222,21 +610,69 @@ func (m Model) View() string {
 			Render(msg)
 	}
 
+	// Running timer shows in every header
+	status := m.timerHeaderStatus()
+
 	switch m.view {
+	case "unlock":
+		return ui.RenderUnlock(m.width, m.height, status, m.passphraseInput, m.unlocking, m.statusMsg)
 	case "entry":
-		return ui.RenderEntryForm(m.width, m.height, m.textarea, m.statusMsg)
+		return ui.RenderEntryForm(m.width, m.height, status, m.textarea, m.statusMsg)
 	case "entries":
-		return ui.RenderEntryList(m.width, m.height, m.entries, m.selectedEntry, m.todos, m.filterTags, m.filterDate)
+		return ui.RenderEntryList(m.width, m.height, status, m.filteredDisplayEntries(), m.entryList.HasPrivate(), m.selectedEntry, m.filterTags, m.filterPeople, m.filterText, m.filterDate, m.revealPrivate, m.statusMsg)
 	case "view_entry":
-		return ui.RenderEntryView(m.width, m.height, m.viewingEntry, m.todos, m.scrollOffset)
+		return ui.RenderEntryView(m.width, m.height, status, m.viewingEntry, m.todos, m.scrollOffset, m.revealPrivate, m.statusMsg)
 	case "todos":
-		return ui.RenderTodoList(m.width, m.height, m.displayTodos, m.entries, m.selectedTodo, m.filterTags, m.filterDate)
+		return ui.RenderTodoList(m.width, m.height, status, m.filteredDisplayTodos(), m.entries, m.selectedTodo, m.filterTags, m.filterPeople, m.filterText, m.filterDate, m.markedTodos, m.statusMsg)
 	case "unified_filter":
-		return ui.RenderUnifiedFilter(m.width, m.height, m.unifiedFilterInput, m.availableTags, m.autocompleteTag, m.statusMsg)
+		return ui.RenderUnifiedFilter(m.width, m.height, status, m.unifiedFilterInput, m.availableTags, m.availablePeople, m.autocompleteTag, m.statusMsg)
 	case "add_todo":
-		return ui.RenderAddTodoForm(m.width, m.height, m.todoInput, m.statusMsg)
+		return ui.RenderAddTodoForm(m.width, m.height, status, m.todoInput, m.statusMsg)
+	case "stats":
+		return ui.RenderStatsView(m.width, m.height, status, m.overviewEntries(), m.historyTodos(), m.statsWeeks)
+	case "bulk_todos":
+		return ui.RenderBulkForm(m.width, m.height, status, m.bulkInput, len(m.bulkTargets()), m.statusMsg)
+	case "archive":
+		return ui.RenderArchiveList(m.width, m.height, status, m.archivedTodos, m.selectedArchived, m.filterTags, m.filterPeople, m.filterText, m.filterDate, m.statusMsg)
+	case "focus":
+		todo, _ := m.focusTodo()
+		now := time.Now()
+		dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		today := helpers.CountPomodorosBetween(m.todos, dayStart, dayStart.AddDate(0, 0, 1))
+		return ui.RenderFocus(m.width, m.height, status, todo, m.focusPhase, helpers.FormatCountdown(m.focusEnds.Sub(now)), len(todo.Pomodoros), today, m.statusMsg)
+	case "timesheet":
+		sheet := helpers.BuildTimesheet(m.reportTodos(), m.timesheetYear, m.timesheetWeek, time.Local, time.Now())
+		return ui.RenderTimesheet(m.width, m.height, status, sheet, m.statusMsg)
+	case "backups":
+		return ui.RenderBackupList(m.width, m.height, status, m.backupPreviews(), m.selectedSnapshot, m.currentCounts(), m.confirmingRestore, m.statusMsg)
+	case "trash":
+		return ui.RenderTrashList(m.width, m.height, status, m.trashedTodos, m.selectedTrashed, m.filterTags, m.filterPeople, m.filterText, m.filterDate, m.statusMsg)
+	case "todo_detail":
+		todo, found := m.detailTodo()
+		input := m.detailInput
+		if ui.TodoDetailFields[m.detailField] == "notes" {
+			input = m.notesInput
+		}
+		entry := m.detailEntry(todo)
+		if entry != nil && !m.revealPrivate {
+			redacted := helpers.RedactPrivateEntry(*entry)
+			entry = &redacted
+		}
+		return ui.RenderTodoDetail(m.width, m.height, status, todo, found, entry, m.detailField, m.detailEditing, input, m.statusMsg)
+	case "agenda":
+		now := time.Now()
+		return ui.RenderAgenda(m.width, m.height, status, helpers.BuildAgenda(m.screenEntries(), m.todos, now), now, m.selectedAgenda, m.statusMsg)
+	case "board":
+		return ui.RenderTodoBoard(m.width, m.height, status, m.filteredDisplayTodos(), m.boardColumn, m.boardRows, m.filterTags, m.filterPeople, m.filterText, m.filterDate, m.statusMsg)
+	case "review":
+		review := helpers.BuildWeeklyReview(m.overviewEntries(), m.reportTodos(), m.reviewYear, m.reviewWeek, time.Local)
+		return ui.RenderReview(m.width, m.height, status, review, m.scrollOffset, m.statusMsg)
+	case "people":
+		return ui.RenderPeopleList(m.width, m.height, status, helpers.SummarizePeople(m.overviewEntries(), m.overviewTodos(m.todos)), m.selectedPerson)
+	case "tags":
+		return ui.RenderTagList(m.width, m.height, status, helpers.CountTagUsage(m.overviewEntries(), m.overviewTodos(m.todos)), m.selectedTag, m.config.TagAliases, m.renamingTag, m.tagInput, m.statusMsg)
 	default:
-		return ui.RenderDashboard(m.width, m.height, m.entries, m.todos)
+		return ui.RenderDashboard(m.width, m.height, status, m.overviewEntries(), m.todos, m.dashboardMode, m.integrityProblems, m.statusMsg)
 	}
 }
 
@@ -8,9 +8,9 @@ import (
 )
 
 // RenderAddTodoForm renders the standalone todo creation form
-func RenderAddTodoForm(width, height int, ti textarea.Model, statusMsg string) string {
+func RenderAddTodoForm(width, height int, headerStatus string, ti textarea.Model, statusMsg string) string {
 	// Header
-	header := RenderHeader(width, "enter", "save", "esc", "cancel")
+	header := RenderHeader(width, headerStatus, "enter", "save", "esc", "cancel")
 
 	// Footer
 	footer := RenderFooter(width, "Add Todo", statusMsg)
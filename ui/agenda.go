@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderAgenda renders the agenda (today) view
+// selectedIdx indexes agenda.Todos() (sections in display order)
+func RenderAgenda(width, height int, headerStatus string, agenda helpers.Agenda, now time.Time, selectedIdx int, statusMsg string) string {
+	// Styles
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+
+	var lines []string
+	selectedLine := 0
+	todoIdx := 0
+
+	addSection := func(title string, todos []models.Todo) {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, titleStyle.Render(fmt.Sprintf("%s (%d)", title, len(todos))))
+		if len(todos) == 0 {
+			lines = append(lines, mutedStyle.Render("  Nothing here"))
+		}
+
+		for _, todo := range todos {
+			checkbox := "[ ]" // open
+			if todo.Status == "next" {
+				checkbox = "[>]" // next (brutalist arrow)
+			}
+
+			line := fmt.Sprintf("%s %s", checkbox, todo.Title)
+			if todo.Due != nil {
+				line += "  due " + todo.Due.Format("Mon 01-02")
+			} else if todo.Scheduled != nil {
+				line += "  sched " + todo.Scheduled.Format("Mon 01-02")
+			}
+
+			// Truncate if too long
+			maxLen := width - 6
+			if len(line) > maxLen {
+				line = line[:maxLen-3] + "..."
+			}
+
+			// Style selected item with inverted colors (brutalist full-width bar)
+			if todoIdx == selectedIdx {
+				selectedLine = len(lines)
+				selectedStyle := lipgloss.NewStyle().
+					Foreground(subtleColor).
+					Reverse(true).
+					Width(width - 4)
+				lines = append(lines, selectedStyle.Render(line))
+			} else {
+				lines = append(lines, textStyle.Render(line))
+			}
+			todoIdx++
+		}
+	}
+
+	addSection("Overdue", agenda.Overdue)
+	addSection("Due today", agenda.Today)
+	addSection("Next", agenda.Next)
+	addSection("Unfinished from yesterday", agenda.FromYesterday)
+
+	// Today's entries (read-only timeline)
+	lines = append(lines, "", titleStyle.Render(fmt.Sprintf("Written today (%d)", len(agenda.Entries))))
+	if len(agenda.Entries) == 0 {
+		lines = append(lines, mutedStyle.Render("  Nothing yet. Press n to write."))
+	}
+	for _, entry := range agenda.Entries {
+		line := fmt.Sprintf("%s  %s", entry.Timestamp.Format("15:04"), entry.Title)
+		if len(line) > width-6 {
+			line = line[:width-9] + "..."
+		}
+		lines = append(lines, textStyle.Render(line))
+	}
+
+	// Calculate viewport (keep selected todo visible)
+	availableHeight := height - 2 // header + footer
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+	start, end := viewportWindow(len(lines), selectedLine, availableHeight)
+	mainContent := strings.Join(lines[start:end], "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "j/k", "nav", "space", "cycle", "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+
+	// Footer
+	footerStats := fmt.Sprintf("%d overdue, %d today, %d next", len(agenda.Overdue), len(agenda.Today), len(agenda.Next)+len(agenda.FromYesterday))
+	if statusMsg != "" {
+		footerStats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
+	footer := RenderFooter(width, "Agenda "+now.Format("Mon 2006-01-02"), footerStats)
+
+	// Calculate padding for content area
+	mainLines := strings.Count(mainContent, "\n") + 1
+	padding := availableHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// storedTodoList describes one browser over todos kept outside todos.json (archive or trash)
+type storedTodoList struct {
+	title      string                       // Footer title, e.g. "Archive"
+	noun       string                       // Footer count noun, e.g. "archived"
+	empty      string                       // Message when the file has no todos
+	emptyMatch string                       // Message when no todos match the filters
+	stamp      func(models.Todo) *time.Time // When the todo was moved (shown as "<noun> 01-02")
+}
+
+// RenderArchiveList renders the archive browser (todos from archive.json, most recently archived first)
+func RenderArchiveList(width, height int, headerStatus string, archived []models.Todo, selectedIdx int, filterTags []string, filterPeople []string, filterText []string, filterDate string, statusMsg string) string {
+	list := storedTodoList{
+		title:      "Archive",
+		noun:       "archived",
+		empty:      "Archive is empty. Done todos move here automatically.",
+		emptyMatch: "No archived todos match the filter.",
+		stamp:      func(todo models.Todo) *time.Time { return todo.ArchivedAt },
+	}
+	return renderStoredTodoList(width, height, headerStatus, list, archived, selectedIdx, filterTags, filterPeople, filterText, filterDate, statusMsg)
+}
+
+// renderStoredTodoList renders a read-only, filterable todo list with restore (archive and trash browsers)
+func renderStoredTodoList(width, height int, headerStatus string, info storedTodoList, todos []models.Todo, selectedIdx int, filterTags []string, filterPeople []string, filterText []string, filterDate string, statusMsg string) string {
+	// Apply filters: first date, then tags, then people, then text (same as todo list)
+	filtered := helpers.FilterTodosByDateRange(todos, filterDate)
+	filtered = helpers.FilterTodosByTags(filtered, filterTags)
+	filtered = helpers.FilterTodosByPeople(filtered, filterPeople)
+	filtered = helpers.FilterTodosByText(filtered, filterText)
+
+	hasFilters := len(filterTags) > 0 || len(filterPeople) > 0 || len(filterText) > 0 || filterDate != ""
+
+	// Viewport
+	availableHeight := height - 2 // header + footer
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+	start, end := viewportWindow(len(filtered), selectedIdx, availableHeight)
+
+	// Build list
+	var listItems []string
+	if len(filtered) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Width(width - 4).
+			Align(lipgloss.Center)
+		if hasFilters {
+			listItems = append(listItems, emptyStyle.Render(info.emptyMatch))
+		} else {
+			listItems = append(listItems, emptyStyle.Render(info.empty))
+		}
+	}
+
+	for i := start; i < end; i++ {
+		todo := filtered[i]
+
+		checkbox := "[ ]" // open
+		if todo.Status == "next" {
+			checkbox = "[>]"
+		} else if todo.Status == "done" {
+			checkbox = "[x]"
+		}
+
+		// Table format: checkbox  created  title (padded)  archived/deleted date  tags
+		titleWidth := 35
+		paddedTitle := todo.Title
+		if len(paddedTitle) > titleWidth {
+			paddedTitle = paddedTitle[:titleWidth]
+		} else {
+			paddedTitle = paddedTitle + strings.Repeat(" ", titleWidth-len(paddedTitle))
+		}
+		line := fmt.Sprintf("%s %s  %s", checkbox, todo.CreatedAt.Format("2006-01-02"), paddedTitle)
+		if stamp := info.stamp(todo); stamp != nil {
+			line += " " + info.noun + " " + stamp.Format("01-02")
+		}
+		for _, tag := range todo.Tags {
+			line += " @" + tag
+		}
+
+		// Truncate if too long
+		maxLen := width - 6
+		if len(line) > maxLen {
+			line = line[:maxLen-3] + "..."
+		}
+
+		// Stored todos are history: muted, selected row inverted
+		if i == selectedIdx {
+			selectedStyle := lipgloss.NewStyle().
+				Foreground(subtleColor).
+				Reverse(true).
+				Width(width - 4)
+			listItems = append(listItems, selectedStyle.Render(line))
+		} else {
+			listItems = append(listItems, lipgloss.NewStyle().Foreground(mutedColor).Render(line))
+		}
+	}
+	list := strings.Join(listItems, "\n")
+
+	// Header
+	var header string
+	if hasFilters {
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "R", "restore", "/", "clear", "t", "todos", "u", "undo", "esc", "cancel", "q", "quit")
+	} else {
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "R", "restore", "/", "filter", "t", "todos", "u", "undo", "esc", "cancel", "q", "quit")
+	}
+
+	// Footer
+	footerTitle := info.title
+	if len(filterTags) > 0 {
+		footerTitle += " " + strings.Join(filterTags, " ")
+	}
+	if len(filterPeople) > 0 {
+		footerTitle += " " + strings.Join(filterPeople, " ")
+	}
+	for _, term := range filterText {
+		footerTitle += " \"" + term + "\""
+	}
+	if filterDate != "" {
+		dateLabel := helpers.FormatDatePreset(filterDate)
+		if dateLabel != "" {
+			footerTitle += " " + dateLabel
+		}
+	}
+
+	stats := fmt.Sprintf("%d %s", len(filtered), info.noun)
+	if len(filtered) > availableHeight {
+		stats = fmt.Sprintf("%d-%d of %d %s", start+1, end, len(filtered), info.noun)
+	}
+	if statusMsg != "" {
+		stats = statusMsg // Show status (e.g. restore, undo) instead of stats
+	}
+	footer := RenderFooter(width, footerTitle, stats)
+
+	// Calculate padding for content area
+	contentHeight := height - 2 // header + footer
+	listLines := strings.Count(list, "\n") + 1
+	padding := contentHeight - listLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + list
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BackupPreview is a backup snapshot with what it holds (or the current data files, to compare)
+type BackupPreview struct {
+	Name     string
+	Taken    time.Time
+	Readable bool // False when the snapshot can't be decrypted with the current passphrase
+	Entries  int
+	Todos    int
+	Archived int
+	Trashed  int
+}
+
+// RenderBackupList renders the backup snapshots (newest first) and what restoring the selected one would change
+func RenderBackupList(width, height int, headerStatus string, backups []BackupPreview, selectedIdx int, current BackupPreview, confirming string, statusMsg string) string {
+	var sections []string
+
+	// Content area is split: snapshot list on top, restore preview below
+	contentHeight := height - 2 // header + footer
+	listHeight := contentHeight - 8
+	if listHeight < 5 {
+		listHeight = 5
+	}
+
+	if len(backups) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Width(width - 4).
+			Align(lipgloss.Center)
+		sections = append(sections, emptyStyle.Render("No backups yet. One is taken before the first save of each hour."))
+	} else {
+		// Calculate window start and end to keep selected item visible
+		start := 0
+		end := len(backups)
+
+		if len(backups) > listHeight {
+			// Center selected item in viewport
+			half := listHeight / 2
+			start = selectedIdx - half
+			end = selectedIdx + half + 1
+
+			// Adjust if near beginning
+			if start < 0 {
+				start = 0
+				end = listHeight
+			}
+
+			// Adjust if near end
+			if end > len(backups) {
+				end = len(backups)
+				start = end - listHeight
+				if start < 0 {
+					start = 0
+				}
+			}
+		}
+
+		var listItems []string
+		for i := start; i < end; i++ {
+			backup := backups[i]
+
+			// Table format: taken  age  counts
+			line := fmt.Sprintf("%s  %-8s  ", backup.Taken.Format("2006-01-02 15:04"), formatBackupAge(current.Taken.Sub(backup.Taken)))
+			if backup.Readable {
+				line += fmt.Sprintf("%5d entries  %5d todos  %4d archived  %4d trashed",
+					backup.Entries, backup.Todos, backup.Archived, backup.Trashed)
+			} else {
+				line += "unreadable (older passphrase?)"
+			}
+
+			// Truncate if too long
+			maxLen := width - 6
+			if len(line) > maxLen {
+				line = line[:maxLen-3] + "..."
+			}
+
+			// Style selected item with inverted colors (brutalist full-width bar)
+			var styled string
+			if i == selectedIdx {
+				selectedStyle := lipgloss.NewStyle().
+					Foreground(subtleColor).
+					Reverse(true).
+					Width(width - 4)
+				styled = selectedStyle.Render(line)
+			} else {
+				normalStyle := lipgloss.NewStyle().Foreground(subtleColor)
+				styled = normalStyle.Render(line)
+			}
+
+			listItems = append(listItems, styled)
+		}
+		sections = append(sections, strings.Join(listItems, "\n"))
+
+		// What restoring the selected snapshot would change
+		if selectedIdx >= 0 && selectedIdx < len(backups) {
+			backup := backups[selectedIdx]
+
+			titleText := "Restore " + backup.Name
+			if confirming == backup.Name {
+				titleText += " (press R again)"
+			}
+			title := lipgloss.NewStyle().
+				Bold(true).
+				Foreground(accentColor).
+				Render(titleText)
+
+			var previewLines []string
+			if backup.Readable {
+				previewLines = append(previewLines,
+					formatBackupChange("entries", current.Entries, backup.Entries),
+					formatBackupChange("todos", current.Todos, backup.Todos),
+					formatBackupChange("archived", current.Archived, backup.Archived),
+					formatBackupChange("trashed", current.Trashed, backup.Trashed),
+				)
+			} else {
+				previewLines = append(previewLines, "  Can't be previewed with the current passphrase")
+			}
+			for i, line := range previewLines {
+				previewLines[i] = lipgloss.NewStyle().Foreground(subtleColor).Render(line)
+			}
+			note := lipgloss.NewStyle().Foreground(mutedColor).Render("  The current files are backed up first; undo history is cleared")
+
+			sections = append(sections, "", title, strings.Join(previewLines, "\n"), note)
+		}
+	}
+
+	list := strings.Join(sections, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "j/k", "nav", "R", "restore", "r", "refresh", "esc", "cancel", "q", "quit")
+
+	// Footer
+	stats := fmt.Sprintf("%d snapshots", len(backups))
+	if statusMsg != "" {
+		stats = statusMsg // Show status (e.g. restore confirmation) instead of stats
+	}
+	footer := RenderFooter(width, "Backups", stats)
+
+	// Calculate padding for content area
+	listLines := strings.Count(list, "\n") + 1
+	padding := contentHeight - listLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + list
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
+
+// formatBackupChange describes one count before and after a restore, e.g. "  entries   120 → 115  (-5)"
+func formatBackupChange(label string, now, restored int) string {
+	line := fmt.Sprintf("  %-9s %5d → %d", label, now, restored)
+	if diff := restored - now; diff != 0 {
+		line += fmt.Sprintf("  (%+d)", diff)
+	}
+	return line
+}
+
+// formatBackupAge is a short age like "5m ago", "3h ago" or "2d ago"
+func formatBackupAge(age time.Duration) string {
+	switch {
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderBulkForm renders the bulk command input for marked todos
+func RenderBulkForm(width, height int, headerStatus string, ti textarea.Model, count int, statusMsg string) string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	lines := []string{
+		titleStyle.Render(fmt.Sprintf("Apply to %d todos", count)),
+		"",
+		ti.View(),
+		"",
+		mutedStyle.Render("open | next | done    set status (status <name> for others)"),
+		mutedStyle.Render("@tag | -@tag          add / remove a tag"),
+		mutedStyle.Render("due fri | due none    set / clear due date"),
+		mutedStyle.Render("archive               move to archive.json"),
+		mutedStyle.Render("delete                move to trash.json"),
+	}
+	mainContent := strings.Join(lines, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "enter", "apply", "esc", "cancel")
+
+	// Footer: hint unless there is an error to show
+	footerStats := helpers.BulkCommandHint
+	if statusMsg != "" {
+		footerStats = statusMsg
+	}
+	footer := RenderFooter(width, "Bulk Edit", footerStats)
+
+	// Calculate padding for content area
+	contentHeight := height - 2 // header + footer
+	mainLines := lipgloss.Height(mainContent)
+	padding := contentHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/apodacaa/amos/internal/helpers"
 	"github.com/apodacaa/amos/internal/models"
@@ -10,9 +11,15 @@ import (
 )
 
 // RenderDashboard renders the main dashboard view
-func RenderDashboard(width, height int, entries []models.Entry, todos []models.Todo) string {
-	// Header
-	header := RenderHeader(width, "n", "new", "a", "todo", "t", "todos", "e", "entries", "q", "quit")
+// mode selects the activity section: "heatmap" (year calendar) or anything else (13-week line graph)
+// problems (found by the integrity check at load) adds a warning badge to the footer
+func RenderDashboard(width, height int, headerStatus string, entries []models.Entry, todos []models.Todo, mode string, problems int, statusMsg string) string {
+	// Header (v toggles to the other activity view)
+	toggleLabel := "heatmap"
+	if mode == "heatmap" {
+		toggleLabel = "graph"
+	}
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "t", "todos", "e", "entries", "v", toggleLabel, "q", "quit")
 
 	// Calculate stats for footer
 	totalEntries := len(entries)
@@ -32,7 +39,14 @@ func RenderDashboard(width, height int, entries []models.Entry, todos []models.T
 
 	// Footer with stats
 	footerStats := fmt.Sprintf("%d entries, %d open todos, %d next todos, %d done todos", totalEntries, openTodos, nextTodos, doneTodos)
-	footer := RenderFooter(width, "Dashboard", footerStats)
+	if statusMsg != "" {
+		footerStats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
+	footerTitle := "Dashboard"
+	if problems > 0 {
+		footerTitle += fmt.Sprintf("  ⚠ %d data problems (amos fsck)", problems)
+	}
+	footer := RenderFooter(width, footerTitle, footerStats)
 
 	// Massive ASCII art title - centered
 	title := lipgloss.NewStyle().
@@ -51,17 +65,31 @@ func RenderDashboard(width, height int, entries []models.Entry, todos []models.T
 			"",
 		}, "\n"))
 
-	// Weekly stats (90 days = ~13 weeks)
-	weekStats := helpers.AggregateByWeek(entries, todos, 13)
+	var statsSection string
+	if mode == "heatmap" {
+		// Year calendar of journaling consistency
+		now := time.Now()
+		weeks := helpers.BuildYearHeatmap(entries, now)
+		currentStreak, longestStreak := helpers.JournalingStreaks(entries, now)
+		statsSection = RenderHeatmap(weeks, currentStreak, longestStreak, width)
+	} else {
+		// Weekly stats (90 days = ~13 weeks)
+		weekStats := helpers.AggregateByWeek(entries, todos, 13)
+
+		// Calculate available height for graph (total - header - footer - title - tag rollup)
+		titleLines := 8 // ASCII art lines
+		rollupLines := 2
+		availableHeight := height - 2 - titleLines - rollupLines
+		if availableHeight < 15 {
+			availableHeight = 15 // Minimum for readable graph
+		}
 
-	// Calculate available height for graph (total - header - footer - title)
-	titleLines := 8 // ASCII art lines
-	availableHeight := height - 2 - titleLines
-	if availableHeight < 15 {
-		availableHeight = 15 // Minimum for readable graph
+		statsSection = RenderLineGraph(weekStats, width, availableHeight)
 	}
 
-	statsSection := RenderLineGraph(weekStats, width, availableHeight)
+	// Per top-level tag rollup (@client/acme counts towards @client)
+	tagStats := helpers.AggregateByTopLevelTag(entries, todos)
+	statsSection += "\n\n" + RenderTagRollup(tagStats, width)
 
 	// Calculate content area (height - header - footer)
 	contentHeight := height - 2 // 1 for header, 1 for footer
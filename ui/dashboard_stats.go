@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/apodacaa/amos/internal/helpers"
 	"github.com/charmbracelet/lipgloss"
@@ -129,3 +130,120 @@ func RenderLineGraph(weekStats []helpers.WeekStats, width, height int) string {
 
 	return strings.Join(lines, "\n")
 }
+
+// RenderTagRollup renders a single line of top-level tag counts (entries/todos)
+// Format: "Tags  @client 12/4  @work 8/2  ..." truncated to fit width
+func RenderTagRollup(tagStats []helpers.TagStats, width int) string {
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+	axisStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	if len(tagStats) == 0 {
+		return axisStyle.Render("Tags  none yet")
+	}
+
+	legend := "  (entries/todos)"
+	line := "Tags"
+	maxLen := width - 8 - len(legend)
+	for _, ts := range tagStats {
+		part := fmt.Sprintf("  %s %d/%d", ts.Tag, ts.EntryCount, ts.TodoCount)
+		if len(line)+len(part) > maxLen {
+			break
+		}
+		line += part
+	}
+
+	return textStyle.Render(line) + axisStyle.Render(legend)
+}
+
+// RenderHeatmap renders a GitHub-style year heatmap (weeks x weekdays) with journaling streaks
+// Cells use monochrome shading: ░ none, ▒ some, ▓ more, █ most (blank = future)
+func RenderHeatmap(weeks [][7]helpers.HeatmapDay, currentStreak, longestStreak int, width int) string {
+	if len(weeks) == 0 {
+		return ""
+	}
+
+	// Styles
+	axisStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+
+	// Double-width cells when the terminal has room (square-ish cells)
+	const labelWidth = 5 // "Mon  "
+	cellWidth := 1
+	if width-8 >= labelWidth+len(weeks)*2 {
+		cellWidth = 2
+	}
+
+	// Find max count for shading levels
+	maxCount := 0
+	total := 0
+	for _, week := range weeks {
+		for _, day := range week {
+			if day.Count > maxCount {
+				maxCount = day.Count
+			}
+			total += day.Count
+		}
+	}
+
+	shade := func(day helpers.HeatmapDay) string {
+		if day.Future {
+			return strings.Repeat(" ", cellWidth)
+		}
+		level := "░"
+		if day.Count > 0 {
+			// Split 1..maxCount into thirds
+			switch {
+			case day.Count*3 <= maxCount:
+				level = "▒"
+			case day.Count*3 <= maxCount*2:
+				level = "▓"
+			default:
+				level = "█"
+			}
+		}
+		return strings.Repeat(level, cellWidth)
+	}
+
+	var lines []string
+
+	// Month labels positioned over the first week of each month
+	monthLine := []rune(strings.Repeat(" ", labelWidth+len(weeks)*cellWidth+3))
+	lastMonth := time.Month(0)
+	for w, week := range weeks {
+		month := week[0].Date.Month()
+		if month == lastMonth {
+			continue
+		}
+		lastMonth = month
+		label := []rune(month.String()[:3])
+		pos := labelWidth + w*cellWidth
+		// Skip labels that would overlap the previous one
+		if pos > 0 && monthLine[pos-1] != ' ' {
+			continue
+		}
+		for j, r := range label {
+			if pos+j < len(monthLine) {
+				monthLine[pos+j] = r
+			}
+		}
+	}
+	lines = append(lines, axisStyle.Render(strings.TrimRight(string(monthLine), " ")))
+
+	// One row per weekday (Monday first, ISO 8601)
+	dayLabels := []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	for d := 0; d < 7; d++ {
+		label := fmt.Sprintf("%-*s", labelWidth, dayLabels[d])
+		var row strings.Builder
+		for _, week := range weeks {
+			row.WriteString(shade(week[d]))
+		}
+		lines = append(lines, axisStyle.Render(label)+textStyle.Render(row.String()))
+	}
+
+	// Legend and streaks
+	lines = append(lines, "")
+	lines = append(lines, textStyle.Render(fmt.Sprintf("Less ░▒▓█ More   %d entries in the last year", total)))
+	lines = append(lines, textStyle.Render(fmt.Sprintf("Current streak %d days   Longest streak %d days", currentStreak, longestStreak)))
+
+	return strings.Join(lines, "\n")
+}
@@ -8,9 +8,9 @@ import (
 )
 
 // RenderEntryForm renders the entry editing form
-func RenderEntryForm(width, height int, ta textarea.Model, statusMsg string) string {
+func RenderEntryForm(width, height int, headerStatus string, ta textarea.Model, statusMsg string) string {
 	// Header
-	header := RenderHeader(width, "ctrl+s", "save", "esc", "cancel")
+	header := RenderHeader(width, headerStatus, "ctrl+s", "save", "esc", "cancel")
 
 	// Footer
 	footer := RenderFooter(width, "New Entry", statusMsg)
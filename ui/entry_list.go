@@ -10,14 +10,9 @@ import (
 )
 
 // RenderEntryList renders the entry list view
-func RenderEntryList(width, height int, entries []models.Entry, selectedIdx int, todos []models.Todo, filterTags []string, filterDate string) string {
-	// Apply filters: first date, then tags
-	filtered := helpers.FilterEntriesByDateRange(entries, filterDate)
-	filtered = helpers.FilterEntriesByTags(filtered, filterTags)
-
-	// Sort entries by timestamp (newest first)
-	sorted := helpers.SortEntriesForDisplay(filtered)
-
+// sorted is already filtered and sorted (newest first); the filters are only shown in the footer
+// Private entries show as "(private)" (no tags or people) unless revealPrivate; hasPrivate offers the p key
+func RenderEntryList(width, height int, headerStatus string, sorted []models.Entry, hasPrivate bool, selectedIdx int, filterTags []string, filterPeople []string, filterText []string, filterDate string, revealPrivate bool, statusMsg string) string {
 	// Build entry list
 	var listItems []string
 
@@ -63,6 +58,9 @@ func RenderEntryList(width, height int, entries []models.Entry, selectedIdx int,
 		// Render visible items
 		for i := start; i < end; i++ {
 			entry := sorted[i]
+			if !revealPrivate {
+				entry = helpers.RedactPrivateEntry(entry)
+			}
 			// Table format: date  title (padded)  tags
 			timestamp := entry.Timestamp.Format("2006-01-02")
 
@@ -86,6 +84,11 @@ func RenderEntryList(width, height int, entries []models.Entry, selectedIdx int,
 				line += tagStr
 			}
 
+			// Add people if present (after tags)
+			for _, person := range entry.People {
+				line += " +" + person
+			}
+
 			// Truncate if too long
 			maxLen := width - 6
 			if len(line) > maxLen {
@@ -111,20 +114,30 @@ func RenderEntryList(width, height int, entries []models.Entry, selectedIdx int,
 
 	list := strings.Join(listItems, "\n")
 
-	// Header
-	hasFilters := len(filterTags) > 0 || filterDate != ""
-	var header string
+	// Header (p only when there is something to reveal)
+	hasFilters := len(filterTags) > 0 || len(filterPeople) > 0 || len(filterText) > 0 || filterDate != ""
+	filterKey := "filter"
 	if hasFilters {
-		header = RenderHeader(width, "n", "new", "a", "todo", "j/k", "nav", "enter", "view", "/", "clear", "t", "todos", "esc", "cancel", "q", "quit")
-	} else {
-		header = RenderHeader(width, "n", "new", "a", "todo", "j/k", "nav", "enter", "view", "/", "filter", "t", "todos", "esc", "cancel", "q", "quit")
+		filterKey = "clear"
+	}
+	keys := []string{"n", "new", "a", "todo", "j/k", "nav", "enter", "view", "/", filterKey}
+	if hasPrivate {
+		keys = append(keys, "p", privateKeyLabel(revealPrivate))
 	}
+	keys = append(keys, "t", "todos", "esc", "cancel", "q", "quit")
+	header := RenderHeader(width, headerStatus, keys...)
 
 	// Footer
 	footerTitle := "Entries"
 	if len(filterTags) > 0 {
 		footerTitle += " " + strings.Join(filterTags, " ")
 	}
+	if len(filterPeople) > 0 {
+		footerTitle += " " + strings.Join(filterPeople, " ")
+	}
+	for _, term := range filterText {
+		footerTitle += " \"" + term + "\""
+	}
 	if filterDate != "" {
 		dateLabel := helpers.FormatDatePreset(filterDate)
 		if dateLabel != "" {
@@ -166,6 +179,9 @@ func RenderEntryList(width, height int, entries []models.Entry, selectedIdx int,
 		}
 	}
 
+	if statusMsg != "" {
+		stats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
 	footer := RenderFooter(width, footerTitle, stats)
 
 	// Calculate padding for content area
@@ -10,7 +10,16 @@ import (
 )
 
 // RenderEntryView renders a read-only view of an entry
-func RenderEntryView(width, height int, entry models.Entry, allTodos []models.Todo, scrollOffset int) string {
+// A private entry shows only its date (no title, body, tags or todos) unless revealPrivate
+func RenderEntryView(width, height int, headerStatus string, entry models.Entry, allTodos []models.Todo, scrollOffset int, revealPrivate bool, statusMsg string) string {
+	private := helpers.IsPrivateEntry(entry)
+	hidden := private && !revealPrivate
+	if hidden {
+		entry = helpers.RedactPrivateEntry(entry)
+		entry.TodoIDs = nil
+		entry.Body = lipgloss.NewStyle().Foreground(mutedColor).Render("Private entry: press p to reveal")
+	}
+
 	// Title at top
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -28,8 +37,10 @@ func RenderEntryView(width, height int, entry models.Entry, allTodos []models.To
 	if len(entry.TodoIDs) > 0 {
 		// Filter todos that belong to this entry
 		entryTodos := helpers.FilterTodosByEntry(allTodos, entry.ID)
+		// Linked todos that were archived, deleted or purged since (not in allTodos)
+		missingCount := helpers.CountMissingTodos(entry.TodoIDs, allTodos)
 
-		if len(entryTodos) > 0 {
+		if len(entryTodos) > 0 || missingCount > 0 {
 			// Count open todos
 			openCount, totalCount := helpers.CountTodoStats(entryTodos)
 
@@ -72,6 +83,11 @@ func RenderEntryView(width, height int, entry models.Entry, allTodos []models.To
 
 				todoLines = append(todoLines, "  "+todoLine)
 			}
+			if missingCount > 0 {
+				todoLines = append(todoLines, "  "+lipgloss.NewStyle().
+					Foreground(mutedColor).
+					Render(fmt.Sprintf("%d more archived or deleted", missingCount)))
+			}
 
 			todosContent := strings.Join(todoLines, "\n")
 			todosSection = "\n\n" + todosTitle + "\n" + todosContent
@@ -119,7 +135,12 @@ func RenderEntryView(width, height int, entry models.Entry, allTodos []models.To
 	}
 
 	// Header
-	header := RenderHeader(width, "n", "new", "a", "todo", "u/i", "scroll", "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+	keys := []string{"n", "new", "a", "todo", "J/K", "scroll"}
+	if private {
+		keys = append(keys, "p", privateKeyLabel(revealPrivate))
+	}
+	keys = append(keys, "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+	header := RenderHeader(width, headerStatus, keys...)
 
 	// Footer: date (no time) + tags + scroll info
 	footerTitle := entry.Timestamp.Format("2006-01-02")
@@ -131,12 +152,18 @@ func RenderEntryView(width, height int, entry models.Entry, allTodos []models.To
 		}
 		footerTitle += " " + strings.Join(tagStrings, " ")
 	}
+	for _, person := range entry.People {
+		footerTitle += " +" + person
+	}
 
 	footerStats := ""
 	if totalLines > availableHeight {
 		footerStats = fmt.Sprintf("lines %d-%d of %d", scrollStart+1, scrollEnd, totalLines)
 	}
 
+	if statusMsg != "" {
+		footerStats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
 	footer := RenderFooter(width, footerTitle, footerStats)
 
 	// Build main content
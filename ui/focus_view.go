@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// bigGlyphs are 5-row block characters for the focus countdown (same blocks as the dashboard title)
+var bigGlyphs = map[rune][]string{
+	'0': {"███", "█ █", "█ █", "█ █", "███"},
+	'1': {"  █", "  █", "  █", "  █", "  █"},
+	'2': {"███", "  █", "███", "█  ", "███"},
+	'3': {"███", "  █", "███", "  █", "███"},
+	'4': {"█ █", "█ █", "███", "  █", "  █"},
+	'5': {"███", "█  ", "███", "  █", "███"},
+	'6': {"███", "█  ", "███", "█ █", "███"},
+	'7': {"███", "  █", "  █", "  █", "  █"},
+	'8': {"███", "█ █", "███", "█ █", "███"},
+	'9': {"███", "█ █", "███", "  █", "███"},
+	':': {" ", "█", " ", "█", " "},
+}
+
+// bigText renders digits and colons in bigGlyphs, each block doubled in width
+func bigText(s string) string {
+	rows := make([]string, 5)
+	for i, r := range s {
+		glyph, ok := bigGlyphs[r]
+		if !ok {
+			continue
+		}
+		for row := range rows {
+			if i > 0 {
+				rows[row] += "  "
+			}
+			for _, c := range glyph[row] {
+				rows[row] += strings.Repeat(string(c), 2)
+			}
+		}
+	}
+	return strings.Join(rows, "\n")
+}
+
+// RenderFocus renders the distraction-free focus screen: phase, big countdown and the todo title, centered
+// phase is "work" or "break"; pomodoros counts completed work intervals on this todo and today
+func RenderFocus(width, height int, headerStatus string, todo models.Todo, phase string, remaining string, todoPomodoros, todayPomodoros int, statusMsg string) string {
+	centered := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+	mutedStyle := centered.Foreground(mutedColor)
+
+	phaseLabel := "FOCUS"
+	if phase == "break" {
+		phaseLabel = "BREAK"
+	}
+
+	// Title wraps (centered) instead of truncating: it is the point of the screen
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(accentColor).
+		Width(width - 8).
+		Align(lipgloss.Center).
+		Render(todo.Title)
+
+	block := strings.Join([]string{
+		mutedStyle.Render(phaseLabel),
+		"",
+		centered.Foreground(accentColor).Bold(true).Render(bigText(remaining)),
+		"",
+		"",
+		centered.Render(title),
+		"",
+		mutedStyle.Render(fmt.Sprintf("%d pomodoros on this todo   %d today", todoPomodoros, todayPomodoros)),
+	}, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "s", "skip", "esc", "leave", "q", "quit")
+
+	// Footer
+	footerStats := "bell at the end of each interval"
+	if statusMsg != "" {
+		footerStats = statusMsg
+	}
+	footer := RenderFooter(width, "Focus", footerStats)
+
+	// Center vertically in the content area
+	contentHeight := height - 2 // header + footer
+	blockLines := lipgloss.Height(block)
+	top := (contentHeight - blockLines) / 2
+	if top < 0 {
+		top = 0
+	}
+	bottom := contentHeight - blockLines - top
+	if bottom < 0 {
+		bottom = 0
+	}
+
+	// Build full view
+	content := header + "\n" + strings.Repeat("\n", top) + block
+	if bottom > 0 {
+		content += strings.Repeat("\n", bottom)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderPeopleList renders the people view (everyone mentioned with +name, plus open todos for the selected person)
+func RenderPeopleList(width, height int, headerStatus string, people []helpers.PersonSummary, selectedIdx int) string {
+	var sections []string
+
+	// Content area is split: people list on top, open todos for selection below
+	contentHeight := height - 2 // header + footer
+	listHeight := contentHeight / 2
+	if listHeight < 5 {
+		listHeight = 5
+	}
+
+	if len(people) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Width(width - 4).
+			Align(lipgloss.Center)
+		sections = append(sections, emptyStyle.Render("No people yet. Mention someone with +name in entries and todos."))
+	} else {
+		// Calculate window start and end to keep selected item visible
+		start := 0
+		end := len(people)
+
+		if len(people) > listHeight {
+			// Center selected item in viewport
+			half := listHeight / 2
+			start = selectedIdx - half
+			end = selectedIdx + half + 1
+
+			// Adjust if near beginning
+			if start < 0 {
+				start = 0
+				end = listHeight
+			}
+
+			// Adjust if near end
+			if end > len(people) {
+				end = len(people)
+				start = end - listHeight
+				if start < 0 {
+					start = 0
+				}
+			}
+		}
+
+		var listItems []string
+		for i := start; i < end; i++ {
+			person := people[i]
+
+			// Table format: +name (padded)  last mentioned  counts
+			nameWidth := 20
+			paddedName := "+" + person.Name
+			if len(paddedName) > nameWidth {
+				paddedName = paddedName[:nameWidth]
+			} else {
+				paddedName = paddedName + strings.Repeat(" ", nameWidth-len(paddedName))
+			}
+
+			line := fmt.Sprintf("%s  %s  %4d entries  %4d todos  %4d open",
+				paddedName, person.LastMentioned.Format("2006-01-02"), person.EntryCount, person.TodoCount, len(person.OpenTodos))
+
+			// Truncate if too long
+			maxLen := width - 6
+			if len(line) > maxLen {
+				line = line[:maxLen-3] + "..."
+			}
+
+			// Style selected item with inverted colors (brutalist full-width bar)
+			var styled string
+			if i == selectedIdx {
+				selectedStyle := lipgloss.NewStyle().
+					Foreground(subtleColor).
+					Reverse(true).
+					Width(width - 4)
+				styled = selectedStyle.Render(line)
+			} else {
+				normalStyle := lipgloss.NewStyle().Foreground(subtleColor)
+				styled = normalStyle.Render(line)
+			}
+
+			listItems = append(listItems, styled)
+		}
+		sections = append(sections, strings.Join(listItems, "\n"))
+
+		// Open todos for selected person
+		if selectedIdx >= 0 && selectedIdx < len(people) {
+			person := people[selectedIdx]
+
+			todosTitle := lipgloss.NewStyle().
+				Bold(true).
+				Foreground(accentColor).
+				Render(fmt.Sprintf("Open todos with +%s (%d)", person.Name, len(person.OpenTodos)))
+
+			var todoLines []string
+			maxTodoLines := contentHeight - listHeight - 3 // blank + title + spacing
+			for i, todo := range person.OpenTodos {
+				if i >= maxTodoLines {
+					todoLines = append(todoLines, fmt.Sprintf("  ... %d more", len(person.OpenTodos)-i))
+					break
+				}
+
+				checkbox := "[ ]"
+				if todo.Status == "next" {
+					checkbox = "[>]"
+				}
+
+				todoLine := fmt.Sprintf("  %s %s", checkbox, todo.Title)
+				if len(todoLine) > width-6 {
+					todoLine = todoLine[:width-9] + "..."
+				}
+				todoLines = append(todoLines, lipgloss.NewStyle().Foreground(subtleColor).Render(todoLine))
+			}
+			if len(person.OpenTodos) == 0 {
+				todoLines = append(todoLines, lipgloss.NewStyle().Foreground(mutedColor).Render("  Nothing open"))
+			}
+
+			sections = append(sections, "", todosTitle, strings.Join(todoLines, "\n"))
+		}
+	}
+
+	list := strings.Join(sections, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "j/k", "nav", "enter", "their todos", "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+
+	// Footer
+	footer := RenderFooter(width, "People", fmt.Sprintf("%d people", len(people)))
+
+	// Calculate padding for content area
+	listLines := strings.Count(list, "\n") + 1
+	padding := contentHeight - listLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + list
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
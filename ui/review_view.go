@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderReview renders the weekly review (the same Markdown that "amos review" prints), scrollable
+func RenderReview(width, height int, headerStatus string, review helpers.WeeklyReview, scrollOffset int, statusMsg string) string {
+	// Styles
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	// The document title lives in the footer, so skip the "# " heading line
+	markdown := helpers.FormatReviewMarkdown(review)
+	docLines := strings.Split(strings.TrimRight(markdown, "\n"), "\n")
+	if len(docLines) > 0 && strings.HasPrefix(docLines[0], "# ") {
+		docLines = docLines[1:]
+	}
+	for len(docLines) > 0 && docLines[0] == "" {
+		docLines = docLines[1:]
+	}
+
+	// Apply scroll offset
+	availableHeight := height - 2 // header + footer
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+	totalLines := len(docLines)
+	maxOffset := totalLines - availableHeight
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if scrollOffset > maxOffset {
+		scrollOffset = maxOffset
+	}
+	if scrollOffset < 0 {
+		scrollOffset = 0
+	}
+	scrollEnd := scrollOffset + availableHeight
+	if scrollEnd > totalLines {
+		scrollEnd = totalLines
+	}
+
+	var lines []string
+	for _, line := range docLines[scrollOffset:scrollEnd] {
+		// Truncate if too long
+		maxLen := width - 6
+		if len(line) > maxLen {
+			line = line[:maxLen-3] + "..."
+		}
+
+		switch {
+		case strings.HasPrefix(line, "### "):
+			lines = append(lines, titleStyle.Render("  "+strings.TrimPrefix(line, "### ")))
+		case strings.HasPrefix(line, "## "):
+			lines = append(lines, titleStyle.Render(strings.TrimPrefix(line, "## ")))
+		case line == "_None_":
+			lines = append(lines, mutedStyle.Render("None"))
+		default:
+			lines = append(lines, textStyle.Render(line))
+		}
+	}
+	mainContent := strings.Join(lines, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "h/l", "week", "J/K", "scroll", "p", "private", "x", "export", "esc", "cancel", "q", "quit")
+
+	// Footer: week + scroll info (or status after export)
+	footerStats := ""
+	if totalLines > availableHeight {
+		footerStats = fmt.Sprintf("lines %d-%d of %d", scrollOffset+1, scrollEnd, totalLines)
+	}
+	if statusMsg != "" {
+		footerStats = statusMsg
+	}
+	footer := RenderFooter(width, "Review "+helpers.WeekID(review.Year, review.Week), footerStats)
+
+	// Calculate padding for content area
+	mainLines := strings.Count(mainContent, "\n") + 1
+	padding := availableHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sparkLevels are the monochrome bar heights used by sparkline (lowest to highest)
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders one bar per value scaled to the max value (zero = blank)
+func sparkline(values []float64) string {
+	maxValue := 0.0
+	for _, v := range values {
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		if v <= 0 || maxValue == 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		idx := int(v / maxValue * float64(len(sparkLevels)-1))
+		b.WriteRune(sparkLevels[idx])
+	}
+	return b.String()
+}
+
+// RenderStatsView renders the interactive stats view for the last windowWeeks ISO weeks
+func RenderStatsView(width, height int, headerStatus string, entries []models.Entry, todos []models.Todo, windowWeeks int) string {
+	// Styles
+	labelStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+
+	now := time.Now()
+	start := helpers.WindowStart(now, windowWeeks)
+	windowEntries := helpers.FilterEntriesBetween(entries, start, now)
+	windowTodos := helpers.FilterTodosBetween(todos, start, now)
+
+	row := func(label, value string) string {
+		return labelStyle.Render(fmt.Sprintf("%-12s", label)) + textStyle.Render(value)
+	}
+
+	var lines []string
+
+	// Summary
+	lines = append(lines, titleStyle.Render(fmt.Sprintf("Last %d weeks  %s to %s", windowWeeks, start.Format("2006-01-02"), now.Format("2006-01-02"))))
+	lines = append(lines, row("Entries", fmt.Sprintf("%d   todos created %d   avg todos/entry %.2f",
+		len(windowEntries), len(windowTodos), helpers.AverageTodosPerEntry(windowEntries))))
+	lines = append(lines, "")
+
+	// Todo flow per week (sparklines, oldest to newest)
+	todoWeeks := helpers.AggregateTodoWeeks(todos, now, windowWeeks)
+	created := make([]float64, len(todoWeeks))
+	completed := make([]float64, len(todoWeeks))
+	rate := make([]float64, len(todoWeeks))
+	backlog := make([]float64, len(todoWeeks))
+	pomodoros := make([]float64, len(todoWeeks))
+	totalCreated, totalCompleted, totalPomodoros := 0, 0, 0
+	rateSum, rateWeeks := 0.0, 0
+	for i, tw := range todoWeeks {
+		created[i] = float64(tw.Created)
+		completed[i] = float64(tw.Completed)
+		rate[i] = tw.CompletionRate
+		backlog[i] = float64(tw.OpenAtEnd)
+		pomodoros[i] = float64(tw.Pomodoros)
+		totalPomodoros += tw.Pomodoros
+		totalCreated += tw.Created
+		totalCompleted += tw.Completed
+		if tw.Created > 0 {
+			rateSum += tw.CompletionRate
+			rateWeeks++
+		}
+	}
+	avgRate := 0.0
+	if rateWeeks > 0 {
+		avgRate = rateSum / float64(rateWeeks)
+	}
+	currentBacklog := 0
+	if len(todoWeeks) > 0 {
+		currentBacklog = todoWeeks[len(todoWeeks)-1].OpenAtEnd
+	}
+
+	lines = append(lines, titleStyle.Render("Todos per week"))
+	lines = append(lines, row("Created", sparkline(created)+fmt.Sprintf("  total %d", totalCreated)))
+	lines = append(lines, row("Completed", sparkline(completed)+fmt.Sprintf("  total %d", totalCompleted)))
+	lines = append(lines, row("Done rate", sparkline(rate)+fmt.Sprintf("  avg %.0f%%", avgRate*100)))
+	lines = append(lines, row("Backlog", sparkline(backlog)+fmt.Sprintf("  now %d open", currentBacklog)))
+	lines = append(lines, row("Pomodoros", sparkline(pomodoros)+fmt.Sprintf("  total %d", totalPomodoros)))
+	if len(todoWeeks) > 0 {
+		first := todoWeeks[0].WeekLabel
+		last := todoWeeks[len(todoWeeks)-1].WeekLabel
+		gap := len(todoWeeks) - len(first) - len(last)
+		if gap < 1 {
+			gap = 1
+		}
+		lines = append(lines, row("", first+strings.Repeat(" ", gap)+last))
+	}
+	lines = append(lines, "")
+
+	// Busiest weekdays and hours
+	weekdays := helpers.CountByWeekday(windowEntries, windowTodos)
+	weekdayNames := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	weekdayValues := make([]float64, 7)
+	busiestDay := 0
+	for i, count := range weekdays {
+		weekdayValues[i] = float64(count)
+		if count > weekdays[busiestDay] {
+			busiestDay = i
+		}
+	}
+	hours := helpers.CountByHour(windowEntries, windowTodos)
+	hourValues := make([]float64, 24)
+	busiestHour := 0
+	for i, count := range hours {
+		hourValues[i] = float64(count)
+		if count > hours[busiestHour] {
+			busiestHour = i
+		}
+	}
+
+	lines = append(lines, titleStyle.Render("Busiest"))
+	lines = append(lines, row("Weekdays", sparkline(weekdayValues)+fmt.Sprintf("  MTWTFSS  top %s (%d)", weekdayNames[busiestDay], weekdays[busiestDay])))
+	lines = append(lines, row("Hours", sparkline(hourValues)+fmt.Sprintf("  top %02d:00 (%d)", busiestHour, hours[busiestHour])))
+	lines = append(lines, row("", "0     6     12    18   23"))
+	lines = append(lines, "")
+
+	// Per-tag breakdown fills the remaining height
+	lines = append(lines, titleStyle.Render("Tags (entries/todos)"))
+	tagStats := helpers.TagBreakdown(windowEntries, windowTodos)
+	remaining := height - 2 - len(lines)
+	if len(tagStats) == 0 {
+		lines = append(lines, labelStyle.Render("No tags in this window"))
+	}
+	for i, ts := range tagStats {
+		if i >= remaining {
+			break
+		}
+		if i == remaining-1 && len(tagStats) > remaining {
+			lines = append(lines, labelStyle.Render(fmt.Sprintf("... %d more", len(tagStats)-i)))
+			break
+		}
+		tag := ts.Tag
+		if len(tag) > 30 {
+			tag = tag[:30]
+		}
+		lines = append(lines, textStyle.Render(fmt.Sprintf("%-30s  %4d  %4d", tag, ts.EntryCount, ts.TodoCount)))
+	}
+
+	mainContent := strings.Join(lines, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "w", "window", "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+
+	// Footer
+	footer := RenderFooter(width, "Stats", fmt.Sprintf("window %d weeks (4/13/52)", windowWeeks))
+
+	// Calculate padding for content area
+	contentHeight := height - 2 // header + footer
+	mainLines := strings.Count(mainContent, "\n") + 1
+	padding := contentHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 )
 
 // Brutalist color palette - Pure monochrome concrete
@@ -124,7 +125,8 @@ func formatHelpWithAlign(width int, align lipgloss.Position, keyDescPairs ...str
 
 // RenderHeader renders the top bar with app name and help shortcuts
 // Format: "AMOS  n:new  a:todo  esc:cancel  q:quit"
-func RenderHeader(width int, keyDescPairs ...string) string {
+// status is shown at the right end (e.g. a running timer), "" for none
+func RenderHeader(width int, status string, keyDescPairs ...string) string {
 	// Build shortcuts string
 	var shortcuts []string
 	for i := 0; i < len(keyDescPairs); i += 2 {
@@ -135,9 +137,18 @@ func RenderHeader(width int, keyDescPairs ...string) string {
 
 	content := strings.Join(shortcuts, "  ")
 
+	// Status takes the right end; shortcuts give way to it
+	// Measured and cut by display width: titles can hold multi-byte and wide characters
+	room := width
+	if status != "" {
+		status = runewidth.Truncate("  "+status, width/2, "")
+		room = width - runewidth.StringWidth(status)
+	}
+
 	// Truncate if too long
-	if len(content) > width {
-		content = content[:width]
+	content = runewidth.Truncate(content, room, "")
+	if status != "" {
+		content += strings.Repeat(" ", room-runewidth.StringWidth(content)) + status
 	}
 
 	// Maximum contrast: inverted accent colors (black bg/white text in dark, white bg/black text in light)
@@ -180,3 +191,11 @@ func RenderFooter(width int, title string, stats string) string {
 
 	return footerStyle.Render(content)
 }
+
+// privateKeyLabel describes what the p key does to private entries
+func privateKeyLabel(revealed bool) string {
+	if revealed {
+		return "hide"
+	}
+	return "reveal"
+}
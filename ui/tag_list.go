@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderTagList renders the tag management view (usage counts, aliases, rename/merge input)
+func RenderTagList(width, height int, headerStatus string, tagStats []helpers.TagStats, selectedIdx int, aliases map[string]string, renamingTag string, ti textarea.Model, statusMsg string) string {
+	// Build reverse alias lookup: canonical -> aliases
+	aliasesFor := make(map[string][]string)
+	for alias, canonical := range aliases {
+		aliasesFor[canonical] = append(aliasesFor[canonical], "@"+alias)
+	}
+	for canonical := range aliasesFor {
+		sort.Strings(aliasesFor[canonical])
+	}
+
+	// Rename input takes 3 lines (blank + label + input)
+	availableHeight := height - 2 // header + footer
+	if renamingTag != "" {
+		availableHeight -= 3
+	}
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+
+	// Build tag list
+	var listItems []string
+
+	if len(tagStats) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Width(width - 4).
+			Align(lipgloss.Center)
+		listItems = append(listItems, emptyStyle.Render("No tags yet. Use @tags in entries and todos."))
+	} else {
+		// Calculate window start and end to keep selected item visible
+		start := 0
+		end := len(tagStats)
+
+		if len(tagStats) > availableHeight {
+			// Center selected item in viewport
+			half := availableHeight / 2
+			start = selectedIdx - half
+			end = selectedIdx + half + 1
+
+			// Adjust if near beginning
+			if start < 0 {
+				start = 0
+				end = availableHeight
+			}
+
+			// Adjust if near end
+			if end > len(tagStats) {
+				end = len(tagStats)
+				start = end - availableHeight
+				if start < 0 {
+					start = 0
+				}
+			}
+		}
+
+		// Render visible tags
+		for i := start; i < end; i++ {
+			ts := tagStats[i]
+
+			// Table format: tag (padded)  entries  todos  aliases
+			tagWidth := 30
+			paddedTag := ts.Tag
+			if len(paddedTag) > tagWidth {
+				paddedTag = paddedTag[:tagWidth]
+			} else {
+				paddedTag = paddedTag + strings.Repeat(" ", tagWidth-len(paddedTag))
+			}
+
+			line := fmt.Sprintf("%s  %4d entries  %4d todos", paddedTag, ts.EntryCount, ts.TodoCount)
+
+			// Show aliases that normalize to this tag
+			if tagAliases := aliasesFor[strings.TrimPrefix(ts.Tag, "@")]; len(tagAliases) > 0 {
+				line += "  aka " + strings.Join(tagAliases, " ")
+			}
+
+			// Truncate if too long
+			maxLen := width - 6
+			if len(line) > maxLen {
+				line = line[:maxLen-3] + "..."
+			}
+
+			// Style selected item with inverted colors (brutalist full-width bar)
+			var styled string
+			if i == selectedIdx {
+				selectedStyle := lipgloss.NewStyle().
+					Foreground(subtleColor).
+					Reverse(true).
+					Width(width - 4)
+				styled = selectedStyle.Render(line)
+			} else {
+				normalStyle := lipgloss.NewStyle().Foreground(subtleColor)
+				styled = normalStyle.Render(line)
+			}
+
+			listItems = append(listItems, styled)
+		}
+	}
+
+	list := strings.Join(listItems, "\n")
+
+	// Rename/merge input below the list
+	if renamingTag != "" {
+		labelStyle := lipgloss.NewStyle().
+			Foreground(subtleColor).
+			Bold(true)
+		list += "\n\n" + labelStyle.Render(fmt.Sprintf("Rename @%s to (existing tag = merge):", renamingTag)) + "\n" + ti.View()
+	}
+
+	// Header
+	var header string
+	if renamingTag != "" {
+		header = RenderHeader(width, headerStatus, "enter", "apply", "esc", "cancel")
+	} else {
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "j/k", "nav", "r", "rename/merge", "e", "entries", "t", "todos", "esc", "cancel", "q", "quit")
+	}
+
+	// Footer: status message takes priority over counts
+	stats := fmt.Sprintf("%d tags, %d aliases", len(tagStats), len(aliases))
+	if statusMsg != "" {
+		stats = statusMsg
+	}
+	footer := RenderFooter(width, "Tags", stats)
+
+	// Calculate padding for content area
+	contentHeight := height - 2 // header + footer
+	listLines := strings.Count(list, "\n") + 1
+	padding := contentHeight - listLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + list
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderTimesheet renders tracked time by tag and weekday for one ISO week (same numbers as the CSV export)
+func RenderTimesheet(width, height int, headerStatus string, sheet helpers.Timesheet, statusMsg string) string {
+	// Styles
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	// Table format: tag (padded)  one h:mm column per day  total
+	const tagWidth = 24
+	cell := func(d time.Duration) string {
+		if d == 0 {
+			return fmt.Sprintf("%6s", "-")
+		}
+		return fmt.Sprintf("%6s", helpers.FormatDuration(d))
+	}
+	row := func(label string, days [7]time.Duration, total time.Duration) string {
+		if len(label) > tagWidth {
+			label = label[:tagWidth-3] + "..."
+		}
+		line := label + strings.Repeat(" ", tagWidth-len(label))
+		for _, d := range days {
+			line += cell(d)
+		}
+		return line + "  " + cell(total)
+	}
+
+	heading := strings.Repeat(" ", tagWidth)
+	for _, day := range []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"} {
+		heading += fmt.Sprintf("%6s", day)
+	}
+	heading += fmt.Sprintf("  %6s", "Total")
+
+	lines := []string{titleStyle.Render(heading), ""}
+	if len(sheet.Rows) == 0 {
+		lines = append(lines, mutedStyle.Render("No time tracked this week. Press s on a todo to start a timer."))
+	}
+	for _, r := range sheet.Rows {
+		label := "@" + r.Tag
+		if r.Tag == helpers.TimesheetUntagged {
+			label = r.Tag
+		}
+		lines = append(lines, textStyle.Render(row(label, r.Days, r.Total)))
+	}
+	if len(sheet.Rows) > 0 {
+		lines = append(lines, "", titleStyle.Render(row("Total", sheet.Days, sheet.Total)))
+		if len(sheet.Rows) > 1 {
+			lines = append(lines, "", mutedStyle.Render("Todos with several tags count in each tag's row."))
+		}
+	}
+
+	// Long tag lists: cut at the bottom (the CSV export has every row)
+	availableHeight := height - 2 // header + footer
+	if len(lines) > availableHeight {
+		lines = lines[:availableHeight-1]
+		lines = append(lines, mutedStyle.Render("... (x exports every row)"))
+	}
+	mainContent := strings.Join(lines, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "n", "new", "a", "todo", "h/l", "week", "p", "private", "x", "export", "t", "todos", "esc", "cancel", "q", "quit")
+
+	// Footer: week + date range (or status after export)
+	weekStart := helpers.ISOWeekStart(sheet.Year, sheet.Week, time.Local)
+	footerStats := fmt.Sprintf("%s - %s, %s tracked", weekStart.Format("Jan 2"), weekStart.AddDate(0, 0, 6).Format("Jan 2"), helpers.FormatDuration(sheet.Total))
+	if statusMsg != "" {
+		footerStats = statusMsg
+	}
+	footer := RenderFooter(width, "Timesheet "+helpers.WeekID(sheet.Year, sheet.Week), footerStats)
+
+	// Calculate padding for content area
+	mainLines := strings.Count(mainContent, "\n") + 1
+	padding := availableHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
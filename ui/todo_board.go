@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderTodoBoard renders todos as a kanban board with one column per status
+// filtered is already filtered (same as the todo list); the filters are only shown in the footer
+// selectedRows holds the selected card per status; only the focused column highlights it
+func RenderTodoBoard(width, height int, headerStatus string, filtered []models.Todo, selectedColumn int, selectedRows map[string]int, filterTags []string, filterPeople []string, filterText []string, filterDate string, statusMsg string) string {
+	columns := helpers.GroupTodosByStatus(filtered)
+
+	// Column layout: equal widths with a one-space gutter
+	colWidth := (width-4)/len(columns) - 1
+	if colWidth < 10 {
+		colWidth = 10
+	}
+
+	// Viewport: header + footer + column title + rule
+	availableHeight := height - 4
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor).Width(colWidth)
+	mutedTitleStyle := lipgloss.NewStyle().Foreground(mutedColor).Width(colWidth)
+
+	var rendered []string
+	for c, column := range columns {
+		focused := c == selectedColumn
+		selectedIdx := selectedRows[column.Status]
+		start, end := viewportWindow(len(column.Todos), selectedIdx, availableHeight)
+
+		// Column title: STATUS (count), plus scroll position when windowed
+		title := fmt.Sprintf("%s (%d)", strings.ToUpper(column.Status), len(column.Todos))
+		if len(column.Todos) > availableHeight {
+			title += fmt.Sprintf(" %d-%d", start+1, end)
+		}
+		var lines []string
+		if focused {
+			lines = append(lines, titleStyle.Render(title))
+		} else {
+			lines = append(lines, mutedTitleStyle.Render(title))
+		}
+		lines = append(lines, lipgloss.NewStyle().Foreground(mutedColor).Render(strings.Repeat("─", colWidth)))
+
+		if len(column.Todos) == 0 {
+			lines = append(lines, lipgloss.NewStyle().Foreground(mutedColor).Width(colWidth).Render("empty"))
+		}
+
+		for i := start; i < end; i++ {
+			todo := column.Todos[i]
+
+			// Card: title plus tags, truncated to column width
+			line := todo.Title
+			for _, tag := range todo.Tags {
+				line += " @" + tag
+			}
+			if len(line) > colWidth {
+				line = line[:colWidth-3] + "..."
+			}
+
+			// Style selected card with inverted colors (focused column only)
+			color := subtleColor
+			if todo.Status == "done" {
+				color = mutedColor
+			}
+			cardStyle := lipgloss.NewStyle().Foreground(color).Width(colWidth)
+			if focused && i == selectedIdx {
+				cardStyle = cardStyle.Reverse(true)
+			}
+			lines = append(lines, cardStyle.Render(line))
+		}
+
+		rendered = append(rendered, strings.Join(lines, "\n"))
+		if c < len(columns)-1 {
+			rendered = append(rendered, " ")
+		}
+	}
+
+	board := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+
+	// Header
+	hasFilters := len(filterTags) > 0 || len(filterPeople) > 0 || len(filterText) > 0 || filterDate != ""
+	var header string
+	if hasFilters {
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "h/l", "column", "H/L", "move", "/", "clear", "t", "list", "esc", "cancel", "q", "quit")
+	} else {
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "h/l", "column", "H/L", "move", "/", "filter", "t", "list", "esc", "cancel", "q", "quit")
+	}
+
+	// Footer
+	footerTitle := "Board"
+	if len(filterTags) > 0 {
+		footerTitle += " " + strings.Join(filterTags, " ")
+	}
+	if len(filterPeople) > 0 {
+		footerTitle += " " + strings.Join(filterPeople, " ")
+	}
+	for _, term := range filterText {
+		footerTitle += " \"" + term + "\""
+	}
+	if filterDate != "" {
+		dateLabel := helpers.FormatDatePreset(filterDate)
+		if dateLabel != "" {
+			footerTitle += " " + dateLabel
+		}
+	}
+	footerStats := fmt.Sprintf("%d todos", len(filtered))
+	if statusMsg != "" {
+		footerStats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
+	footer := RenderFooter(width, footerTitle, footerStats)
+
+	// Calculate padding for content area
+	contentHeight := height - 2 // header + footer
+	boardLines := strings.Count(board, "\n") + 1
+	padding := contentHeight - boardLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + board
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
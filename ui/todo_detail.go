@@ -0,0 +1,190 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TodoDetailFields are the editable fields of the todo detail view, in display order
+var TodoDetailFields = []string{"title", "tags", "status", "notes"}
+
+// RenderTodoDetail renders one todo with its editable fields and metadata
+// entry is the linked source entry (nil for standalone todos or a missing entry)
+// While editing, the selected field shows the input instead of its value (notes: below the metadata)
+func RenderTodoDetail(width, height int, headerStatus string, todo models.Todo, found bool, entry *models.Entry, selectedField int, editing bool, ti textarea.Model, statusMsg string) string {
+	// Styles
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor).Width(width - 8)
+	labelStyle := lipgloss.NewStyle().Foreground(mutedColor).Width(11)
+	textStyle := lipgloss.NewStyle().Foreground(subtleColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	var lines []string
+	if !found {
+		// Todo disappeared (e.g. its creation was undone)
+		lines = append(lines, mutedStyle.Render("This todo no longer exists. Press esc to go back."))
+	} else {
+		lines = append(lines, titleStyle.Render(todo.Title), "")
+
+		// Editable fields
+		values := map[string]string{
+			"title":  todo.Title,
+			"tags":   helpers.FormatTagList(todo.Tags),
+			"status": todo.Status,
+		}
+		if values["tags"] == "" {
+			values["tags"] = "(none)"
+		}
+		values["notes"] = notesSummary(todo.Notes)
+		editingNotes := editing && TodoDetailFields[selectedField] == "notes"
+		for i, field := range TodoDetailFields {
+			label := labelStyle.Render(strings.ToUpper(field[:1]) + field[1:])
+			if editing && i == selectedField && !editingNotes {
+				lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, label, ti.View()))
+				continue
+			}
+
+			value := values[field]
+			maxLen := width - 6 - 11
+			if len(value) > maxLen {
+				value = value[:maxLen-3] + "..."
+			}
+			if i == selectedField {
+				selectedStyle := lipgloss.NewStyle().
+					Foreground(subtleColor).
+					Reverse(true).
+					Width(width - 4 - 11)
+				lines = append(lines, label+selectedStyle.Render(value))
+			} else {
+				lines = append(lines, label+textStyle.Render(value))
+			}
+		}
+
+		// Read-only metadata
+		lines = append(lines, "")
+		addInfo := func(label, value string) {
+			lines = append(lines, labelStyle.Render(label)+mutedStyle.Render(value))
+		}
+		addInfo("Created", todo.CreatedAt.Format("Mon 2006-01-02 15:04"))
+		if todo.CompletedAt != nil {
+			addInfo("Completed", todo.CompletedAt.Format("Mon 2006-01-02 15:04"))
+		}
+		if todo.Due != nil {
+			addInfo("Due", todo.Due.Format("Mon 2006-01-02"))
+		}
+		if todo.Scheduled != nil {
+			addInfo("Scheduled", todo.Scheduled.Format("Mon 2006-01-02"))
+		}
+		if len(todo.TimeLog) > 0 {
+			tracked := helpers.FormatDuration(helpers.TrackedTime(todo, time.Now()))
+			if helpers.TimerRunning(todo) {
+				tracked += " (running)"
+			}
+			addInfo("Tracked", tracked)
+		}
+		if len(todo.People) > 0 {
+			addInfo("People", "+"+strings.Join(todo.People, " +"))
+		}
+		switch {
+		case todo.EntryID == nil:
+			addInfo("Entry", "standalone")
+		case entry == nil:
+			addInfo("Entry", "missing (deleted?)")
+		default:
+			source := fmt.Sprintf("%s  %s", entry.Timestamp.Format("2006-01-02"), entry.Title)
+			if len(source) > width-6-11 {
+				source = source[:width-9-11] + "..."
+			}
+			addInfo("Entry", source)
+		}
+
+		// Notes: full text, or the multi-line editor
+		if editingNotes {
+			lines = append(lines, "", ti.View())
+		} else if todo.Notes != "" {
+			notesStyle := lipgloss.NewStyle().Foreground(subtleColor).Width(width - 8)
+			lines = append(lines, "", notesStyle.Render(todo.Notes))
+		}
+	}
+	mainContent := strings.Join(lines, "\n")
+
+	// Long notes: cut at the bottom (the editor shows everything)
+	contentHeight := height - 2 // header + footer
+	if contentLines := strings.Split(mainContent, "\n"); len(contentLines) > contentHeight {
+		contentLines = contentLines[:contentHeight-1]
+		contentLines = append(contentLines, mutedStyle.Render("... (enter on Notes to see all)"))
+		mainContent = strings.Join(contentLines, "\n")
+	}
+
+	// Header
+	var header string
+	if editing && TodoDetailFields[selectedField] == "notes" {
+		header = RenderHeader(width, headerStatus, "ctrl+s", "save", "esc", "cancel")
+	} else if editing {
+		header = RenderHeader(width, headerStatus, "enter", "save", "esc", "cancel")
+	} else {
+		header = RenderHeader(width, headerStatus, "j/k", "field", "enter", "edit", "space", "cycle", "s", "timer", "o", "entry", "u", "undo", "esc", "back", "q", "quit")
+	}
+
+	// Footer
+	footerStats := ""
+	if editing && TodoDetailFields[selectedField] == "tags" {
+		footerStats = "space-separated, e.g. @work @client/acme"
+	}
+	if editing && TodoDetailFields[selectedField] == "notes" {
+		footerStats = "links, commands, \"- [ ] item\" checklists"
+	}
+	if statusMsg != "" {
+		footerStats = statusMsg
+	}
+	footer := RenderFooter(width, "Todo", footerStats)
+
+	// Calculate padding for content area
+	mainLines := lipgloss.Height(mainContent)
+	padding := contentHeight - mainLines
+	if padding < 0 {
+		padding = 0
+	}
+
+	// Build full view
+	content := header + "\n" + mainContent
+	if padding > 0 {
+		content += strings.Repeat("\n", padding)
+	}
+	content += "\n" + footer
+
+	return content
+}
+
+// notesSummary describes notes on one line: line count plus checklist progress
+func notesSummary(notes string) string {
+	if strings.TrimSpace(notes) == "" {
+		return "(none)"
+	}
+
+	lineCount := strings.Count(strings.TrimRight(notes, "\n"), "\n") + 1
+	summary := fmt.Sprintf("%d lines", lineCount)
+	if lineCount == 1 {
+		summary = "1 line"
+	}
+	if done, total := helpers.ChecklistProgress(notes); total > 0 {
+		summary += fmt.Sprintf(", checklist %d/%d", done, total)
+	}
+	return summary
+}
+
+// notesIndicator is the short marker shown after a todo's title in lists ("" without notes)
+func notesIndicator(notes string) string {
+	if strings.TrimSpace(notes) == "" {
+		return ""
+	}
+	if done, total := helpers.ChecklistProgress(notes); total > 0 {
+		return fmt.Sprintf(" [%d/%d]", done, total)
+	}
+	return " ≡"
+}
@@ -10,10 +10,9 @@ import (
 )
 
 // RenderTodoList renders the todo list view
-func RenderTodoList(width, height int, todos []models.Todo, entries []models.Entry, selectedIdx int, filterTags []string, filterDate string) string {
-	// Apply filters: first date, then tags
-	filtered := helpers.FilterTodosByDateRange(todos, filterDate)
-	filtered = helpers.FilterTodosByTags(filtered, filterTags)
+// filtered is already filtered and sorted; the filters are only shown in the footer
+// marked holds todo IDs selected for a bulk action (shown with a * marker)
+func RenderTodoList(width, height int, headerStatus string, filtered []models.Todo, entries []models.Entry, selectedIdx int, filterTags []string, filterPeople []string, filterText []string, filterDate string, marked map[string]bool, statusMsg string) string {
 
 	// Build todo list
 	var listItems []string
@@ -23,7 +22,7 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 			Foreground(mutedColor).
 			Width(width - 4).
 			Align(lipgloss.Center)
-		if len(filterTags) > 0 {
+		if len(filterTags) > 0 || len(filterPeople) > 0 || len(filterText) > 0 {
 			listItems = append(listItems, emptyStyle.Render("No todos match the filter."))
 		} else {
 			listItems = append(listItems, emptyStyle.Render("No todos yet. Create an entry with !todo lines."))
@@ -39,30 +38,7 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 		}
 
 		// Calculate window start and end to keep selected item visible
-		start := 0
-		end := len(sorted)
-
-		if len(sorted) > availableHeight {
-			// Center selected item in viewport
-			half := availableHeight / 2
-			start = selectedIdx - half
-			end = selectedIdx + half + 1
-
-			// Adjust if near beginning
-			if start < 0 {
-				start = 0
-				end = availableHeight
-			}
-
-			// Adjust if near end
-			if end > len(sorted) {
-				end = len(sorted)
-				start = end - availableHeight
-				if start < 0 {
-					start = 0
-				}
-			}
-		}
+		start, end := viewportWindow(len(sorted), selectedIdx, availableHeight)
 
 		// Render visible todos
 		for i := start; i < end; i++ {
@@ -89,6 +65,28 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 
 			line := fmt.Sprintf("%s %s  %s", checkbox, dateStr, paddedTitle)
 
+			// Mark column only while something is marked (keeps the normal layout unchanged)
+			if len(marked) > 0 {
+				if marked[todo.ID] {
+					line = "* " + line
+				} else {
+					line = "  " + line
+				}
+			}
+
+			// Notes marker (checklist progress when the notes have "- [ ]" items)
+			line += notesIndicator(todo.Notes)
+
+			// Running timer marker (the clock itself is in the header)
+			if helpers.TimerRunning(todo) {
+				line += " [timer]"
+			}
+
+			// Add due date if set
+			if todo.Due != nil {
+				line += " due " + todo.Due.Format("01-02")
+			}
+
 			// Add tags if present (aligned after padded title)
 			if len(todo.Tags) > 0 {
 				tagStr := ""
@@ -98,6 +96,11 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 				line += tagStr
 			}
 
+			// Add people if present (after tags)
+			for _, person := range todo.People {
+				line += " +" + person
+			}
+
 			// Truncate if too long
 			maxLen := width - 6
 			if len(line) > maxLen {
@@ -139,12 +142,12 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 	list := strings.Join(listItems, "\n")
 
 	// Header
-	hasFilters := len(filterTags) > 0 || filterDate != ""
+	hasFilters := len(filterTags) > 0 || len(filterPeople) > 0 || len(filterText) > 0 || filterDate != ""
 	var header string
 	if hasFilters {
-		header = RenderHeader(width, "n", "new", "a", "todo", "j/k", "nav", "space", "cycle", "/", "clear", "e", "entries", "esc", "cancel", "q", "quit")
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "space", "cycle", "u", "undo", "m", "mark", "x", "bulk", "/", "clear", "esc", "cancel", "q", "quit")
 	} else {
-		header = RenderHeader(width, "n", "new", "a", "todo", "j/k", "nav", "space", "cycle", "/", "filter", "e", "entries", "esc", "cancel", "q", "quit")
+		header = RenderHeader(width, headerStatus, "n", "new", "a", "todo", "space", "cycle", "u", "undo", "m", "mark", "x", "bulk", "/", "filter", "esc", "cancel", "q", "quit")
 	}
 
 	// Footer
@@ -152,6 +155,12 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 	if len(filterTags) > 0 {
 		footerTitle += " " + strings.Join(filterTags, " ")
 	}
+	if len(filterPeople) > 0 {
+		footerTitle += " " + strings.Join(filterPeople, " ")
+	}
+	for _, term := range filterText {
+		footerTitle += " \"" + term + "\""
+	}
 	if filterDate != "" {
 		dateLabel := helpers.FormatDatePreset(filterDate)
 		if dateLabel != "" {
@@ -185,22 +194,7 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 
 		if len(filtered) > availableHeight {
 			// Showing windowed view - calculate same viewport as rendering
-			half := availableHeight / 2
-			start := selectedIdx - half
-			end := selectedIdx + half + 1
-
-			if start < 0 {
-				start = 0
-				end = availableHeight
-			}
-
-			if end > len(filtered) {
-				end = len(filtered)
-				start = end - availableHeight
-				if start < 0 {
-					start = 0
-				}
-			}
+			start, end := viewportWindow(len(filtered), selectedIdx, availableHeight)
 
 			stats = fmt.Sprintf("%d-%d of %d | %d open, %d next, %d done", start+1, end, len(filtered), openCount, nextCount, doneCount)
 		} else {
@@ -208,6 +202,20 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 		}
 	}
 
+	// Marked count (visible under the current filter)
+	markedCount := 0
+	for _, todo := range filtered {
+		if marked[todo.ID] {
+			markedCount++
+		}
+	}
+	if markedCount > 0 {
+		stats = fmt.Sprintf("%d marked | %s", markedCount, stats)
+	}
+
+	if statusMsg != "" {
+		stats = statusMsg // Show status (e.g. undo, bulk summary) instead of stats
+	}
 	footer := RenderFooter(width, footerTitle, stats)
 
 	// Calculate padding for content area
@@ -227,3 +235,33 @@ func RenderTodoList(width, height int, todos []models.Todo, entries []models.Ent
 
 	return content
 }
+
+// viewportWindow returns the [start, end) slice of total items to show in visible rows
+// The selected item is kept centered, clamped at the beginning and end of the list
+func viewportWindow(total, selectedIdx, visible int) (start, end int) {
+	if total <= visible {
+		return 0, total
+	}
+
+	// Center selected item in viewport
+	half := visible / 2
+	start = selectedIdx - half
+	end = start + visible
+
+	// Adjust if near beginning
+	if start < 0 {
+		start = 0
+		end = visible
+	}
+
+	// Adjust if near end
+	if end > total {
+		end = total
+		start = end - visible
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	return start, end
+}
@@ -0,0 +1,19 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+)
+
+// RenderTrashList renders the trash (todos from trash.json, most recently deleted first)
+func RenderTrashList(width, height int, headerStatus string, trashed []models.Todo, selectedIdx int, filterTags []string, filterPeople []string, filterText []string, filterDate string, statusMsg string) string {
+	list := storedTodoList{
+		title:      "Trash",
+		noun:       "deleted",
+		empty:      "Trash is empty. Press d on a todo to delete it.",
+		emptyMatch: "No deleted todos match the filter.",
+		stamp:      func(todo models.Todo) *time.Time { return todo.DeletedAt },
+	}
+	return renderStoredTodoList(width, height, headerStatus, list, trashed, selectedIdx, filterTags, filterPeople, filterText, filterDate, statusMsg)
+}
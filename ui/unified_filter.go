@@ -10,9 +10,9 @@ import (
 )
 
 // RenderUnifiedFilter renders the unified filter input view (tags + dates)
-func RenderUnifiedFilter(width, height int, ti textarea.Model, availableTags []string, autocompleteTag string, statusMsg string) string {
+func RenderUnifiedFilter(width, height int, headerStatus string, ti textarea.Model, availableTags []helpers.TagNode, availablePeople []string, autocompleteTag string, statusMsg string) string {
 	// Header
-	header := RenderHeader(width, "tab", "complete", "enter", "apply", "esc", "cancel")
+	header := RenderHeader(width, headerStatus, "tab", "complete", "enter", "apply", "esc", "cancel")
 
 	// Footer with hint
 	footerTitle := "Filter"
@@ -46,9 +46,14 @@ func RenderUnifiedFilter(width, height int, ti textarea.Model, availableTags []s
 		if strings.HasPrefix(currentWord, "@") {
 			wordWithoutAt := strings.TrimPrefix(currentWord, "@")
 			if wordWithoutAt != "" {
-				// Show matching tags
+				// Show matching tags as a tree with counts
 				matches = filterMatchingTags(currentWord, availableTags)
 			}
+		} else if strings.HasPrefix(currentWord, "+") {
+			if len(currentWord) > 1 {
+				// Show matching people
+				matches = filterMatchingPeople(currentWord, availablePeople)
+			}
 		} else {
 			// Show matching date phrases
 			matches = filterMatchingDates(currentWord)
@@ -62,7 +67,21 @@ func RenderUnifiedFilter(width, height int, ti textarea.Model, availableTags []s
 
 			matchesStyle := lipgloss.NewStyle().
 				Foreground(mutedColor)
-			matchesList := matchesStyle.Render(strings.Join(matches, ", "))
+			separator := ", "
+			if strings.HasPrefix(currentWord, "@") {
+				separator = "\n" // Tag tree: one node per line
+
+				// Keep the tree inside the content area (input + hint + label)
+				maxLines := height - 8
+				if maxLines < 1 {
+					maxLines = 1
+				}
+				if len(matches) > maxLines {
+					hidden := len(matches) - maxLines + 1
+					matches = append(matches[:maxLines-1], fmt.Sprintf("... %d more", hidden))
+				}
+			}
+			matchesList := matchesStyle.Render(strings.Join(matches, separator))
 
 			suggestionsSection = lipgloss.JoinVertical(
 				lipgloss.Left,
@@ -119,8 +138,9 @@ func getLastWord(input string) string {
 	return strings.TrimSpace(input[lastSpaceIdx+1:])
 }
 
-// filterMatchingTags returns tags that start with the given prefix (case-insensitive)
-func filterMatchingTags(prefix string, availableTags []string) []string {
+// filterMatchingTags returns tree lines for tags that start with the given prefix (case-insensitive)
+// Each line is indented by depth and shows the rolled-up count: "  @client/acme (7)"
+func filterMatchingTags(prefix string, availableTags []helpers.TagNode) []string {
 	if prefix == "" {
 		return nil
 	}
@@ -129,10 +149,31 @@ func filterMatchingTags(prefix string, availableTags []string) []string {
 	normalizedPrefix := strings.ToLower(strings.TrimPrefix(prefix, "@"))
 
 	var matches []string
-	for _, tag := range availableTags {
-		normalizedTag := strings.ToLower(strings.TrimPrefix(tag, "@"))
+	for _, node := range availableTags {
+		normalizedTag := strings.ToLower(strings.TrimPrefix(node.Tag, "@"))
 		if strings.HasPrefix(normalizedTag, normalizedPrefix) {
-			matches = append(matches, tag)
+			indent := strings.Repeat("  ", node.Depth)
+			matches = append(matches, fmt.Sprintf("%s%s (%d)", indent, node.Tag, node.Count))
+		}
+	}
+
+	return matches
+}
+
+// filterMatchingPeople returns people that start with the given prefix (case-insensitive)
+func filterMatchingPeople(prefix string, availablePeople []string) []string {
+	if prefix == "" {
+		return nil
+	}
+
+	// Normalize prefix (remove + if present, lowercase)
+	normalizedPrefix := strings.ToLower(strings.TrimPrefix(prefix, "+"))
+
+	var matches []string
+	for _, person := range availablePeople {
+		normalizedPerson := strings.ToLower(strings.TrimPrefix(person, "+"))
+		if strings.HasPrefix(normalizedPerson, normalizedPrefix) {
+			matches = append(matches, person)
 		}
 	}
 
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderUnlock renders the passphrase prompt shown before an encrypted journal is loaded
+func RenderUnlock(width, height int, headerStatus string, ti textinput.Model, unlocking bool, statusMsg string) string {
+	centered := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+	mutedStyle := centered.Foreground(mutedColor)
+
+	hint := "entries and todos are encrypted at rest"
+	if unlocking {
+		hint = "unlocking..."
+	}
+
+	block := strings.Join([]string{
+		centered.Bold(true).Foreground(accentColor).Render("PASSPHRASE"),
+		"",
+		centered.Render(lipgloss.NewStyle().Width(40).Render(ti.View())),
+		"",
+		mutedStyle.Render(hint),
+	}, "\n")
+
+	// Header
+	header := RenderHeader(width, headerStatus, "enter", "unlock", "esc", "quit")
+
+	// Footer
+	footer := RenderFooter(width, "Unlock", statusMsg)
+
+	// Center vertically in the content area
+	contentHeight := height - 2 // header + footer
+	blockLines := lipgloss.Height(block)
+	top := (contentHeight - blockLines) / 2
+	if top < 0 {
+		top = 0
+	}
+	bottom := contentHeight - blockLines - top
+	if bottom < 0 {
+		bottom = 0
+	}
+
+	// Build full view
+	content := header + "\n" + strings.Repeat("\n", top) + block
+	if bottom > 0 {
+		content += strings.Repeat("\n", bottom)
+	}
+	content += "\n" + footer
+
+	return content
+}
@@ -33,7 +33,9 @@ func (m Model) handleAddTodoKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		// Set title and extract tags
 		m.currentTodo.Title = title
-		m.currentTodo.Tags = helpers.ExtractTags(title)
+		m.currentTodo.Tags = helpers.ApplyTagAliases(helpers.ExtractTags(title), m.config.TagAliases)
+		m.currentTodo.People = helpers.ExtractPeople(title)
+		m.currentTodo.Due, m.currentTodo.Scheduled = helpers.ExtractSchedule(title, time.Now())
 		m.currentTodo.EntryID = nil // Standalone todo (no entry link)
 
 		// Save current todo
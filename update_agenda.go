@@ -0,0 +1,79 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleAgendaKeys processes keyboard input (agenda / today view)
+func (m Model) handleAgendaKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Same list as the UI (sections flattened in display order)
+	agendaTodos := helpers.BuildAgenda(m.entries, m.todos, time.Now()).Todos()
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "j", "down":
+		if m.selectedAgenda < len(agendaTodos)-1 {
+			m.selectedAgenda++
+		}
+		return m, nil
+	case "k", "up":
+		if m.selectedAgenda > 0 {
+			m.selectedAgenda--
+		}
+		return m, nil
+	case "f":
+		// Focus mode (pomodoro countdown) on the selected "next" todo
+		if m.selectedAgenda >= 0 && m.selectedAgenda < len(agendaTodos) {
+			return m.startFocus(agendaTodos[m.selectedAgenda])
+		}
+		return m, nil
+	case "r":
+		// Refresh - reload to rebuild sections
+		return m, m.loadEntriesAndTodos()
+	case " ":
+		// Cycle todo status (save immediately; finished todos drop off the agenda)
+		if m.selectedAgenda >= 0 && m.selectedAgenda < len(agendaTodos) {
+			var cmd tea.Cmd
+			m, cmd = m.cycleTodoStatus(agendaTodos[m.selectedAgenda])
+			if remaining := len(helpers.BuildAgenda(m.entries, m.todos, time.Now()).Todos()); m.selectedAgenda >= remaining && remaining > 0 {
+				m.selectedAgenda = remaining - 1
+			}
+			return m, cmd
+		}
+		return m, nil
+	}
+	return m, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// filteredArchivedTodos returns archivedTodos with the current filters applied (same as the UI)
+func (m Model) filteredArchivedTodos() []models.Todo {
+	filtered := helpers.FilterTodosByDateRange(m.archivedTodos, m.filterDate)
+	filtered = helpers.FilterTodosByTags(filtered, m.filterTags)
+	filtered = helpers.FilterTodosByPeople(filtered, m.filterPeople)
+	return helpers.FilterTodosByText(filtered, m.filterText)
+}
+
+// historyTodos returns active plus archived todos (stats and review count completed work from both)
+// archivedTodos is only populated after the archive has been loaded
+func (m Model) historyTodos() []models.Todo {
+	return append(append([]models.Todo{}, m.todos...), m.archivedTodos...)
+}
+
+// handleArchiveKeys processes keyboard input (archive browser)
+func (m Model) handleArchiveKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
+	case "esc":
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entry list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "/":
+		// Open unified filter input (or clear all filters if already filtering)
+		if len(m.filterTags) > 0 || len(m.filterPeople) > 0 || len(m.filterText) > 0 || m.filterDate != "" {
+			// Clear all filters
+			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
+			m.filterDate = ""
+			m.selectedArchived = 0
+			m.statusMsg = ""
+			return m, nil
+		}
+		// Open unified filter (suggest tags and people from the archive too)
+		m.filterContext = "archive"
+		m.availableTags = helpers.BuildTagTree(m.overviewEntries(), m.overviewTodos(m.historyTodos()))
+		m.availablePeople = helpers.ExtractUniquePeopleFromAll(m.overviewEntries(), m.overviewTodos(m.historyTodos()))
+		m.unifiedFilterInput.Reset()
+		m.unifiedFilterInput.Focus()
+		m.autocompleteTag = ""
+		m.view = "unified_filter"
+		m.statusMsg = ""
+		return m, textarea.Blink
+	case "j", "down":
+		if m.selectedArchived < len(m.filteredArchivedTodos())-1 {
+			m.selectedArchived++
+		}
+		return m, nil
+	case "k", "up":
+		if m.selectedArchived > 0 {
+			m.selectedArchived--
+		}
+		return m, nil
+	case "r":
+		// Refresh - reload archive.json
+		return m, m.loadArchive()
+	case "R":
+		// Restore selected todo to the todo list
+		filtered := m.filteredArchivedTodos()
+		if m.selectedArchived >= 0 && m.selectedArchived < len(filtered) {
+			return m, m.restoreTodos(map[string]bool{filtered[m.selectedArchived].ID: true})
+		}
+		return m, nil
+	}
+	return m, nil
+}
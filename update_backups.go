@@ -0,0 +1,122 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleBackupsKeys processes keyboard input (backup snapshots and restore)
+func (m Model) handleBackupsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// A pending restore only survives the confirming R
+	confirming := m.confirmingRestore
+	m.confirmingRestore = ""
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "j", "down":
+		if m.selectedSnapshot < len(m.snapshots)-1 {
+			m.selectedSnapshot++
+		}
+		m.statusMsg = ""
+		return m, nil
+	case "k", "up":
+		if m.selectedSnapshot > 0 {
+			m.selectedSnapshot--
+		}
+		m.statusMsg = ""
+		return m, nil
+	case "r":
+		// Refresh (a save may have taken a new snapshot)
+		m.statusMsg = ""
+		return m, m.loadBackups()
+	case "R":
+		if m.selectedSnapshot < 0 || m.selectedSnapshot >= len(m.snapshots) {
+			return m, nil
+		}
+		name := m.snapshots[m.selectedSnapshot].Name
+		if _, ok := m.snapshotCounts[name]; !ok {
+			// Can't preview it, so don't restore it blind (the CLI still can)
+			m.statusMsg = "⚠ Can't read this backup (older passphrase?): use amos backup restore " + name
+			m.statusTime = time.Now()
+			return m, nil
+		}
+		if confirming != name {
+			m.confirmingRestore = name
+			m.statusMsg = "⚠ Press R again to restore " + name + " (current files are backed up first)"
+			m.statusTime = time.Now()
+			return m, nil
+		}
+		m.statusMsg = "restoring " + name + "..."
+		return m, restoreBackup(name)
+	}
+	m.statusMsg = ""
+	return m, nil
+}
+
+// handleBackupsLoaded shows the listed snapshots, keeping the selection in range
+func (m Model) handleBackupsLoaded(msg backupsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.statusMsg = "Error loading backups: " + msg.err.Error()
+		m.statusTime = time.Now()
+		return m, nil
+	}
+	m.snapshots = msg.snapshots
+	m.snapshotCounts = msg.counts
+	if m.selectedSnapshot >= len(m.snapshots) {
+		m.selectedSnapshot = 0
+	}
+	return m, nil
+}
+
+// handleBackupRestored reloads everything after a restore (undo history no longer applies)
+func (m Model) handleBackupRestored(msg backupRestoredMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.statusMsg = "Error restoring backup: " + msg.err.Error()
+		m.statusTime = time.Now()
+		return m, nil
+	}
+	m.undoLog = models.UndoLog{}
+	m.selectedSnapshot = 0 // The backup of the replaced files is now the newest
+	m.statusMsg = "restored backup " + msg.name
+	m.statusTime = time.Now()
+	return m, tea.Batch(m.loadConfig(), m.loadEntriesAndTodos(), m.loadArchive(), m.loadTrash(), m.loadBackups(), m.checkIntegrity(), clearStatusAfterDelay())
+}
+
+// backupPreviews pairs each snapshot with what it holds, for the backups view
+func (m Model) backupPreviews() []ui.BackupPreview {
+	previews := make([]ui.BackupPreview, 0, len(m.snapshots))
+	for _, snapshot := range m.snapshots {
+		counts, ok := m.snapshotCounts[snapshot.Name]
+		previews = append(previews, ui.BackupPreview{
+			Name:     snapshot.Name,
+			Taken:    snapshot.Time,
+			Readable: ok,
+			Entries:  counts.Entries,
+			Todos:    counts.Todos,
+			Archived: counts.Archived,
+			Trashed:  counts.Trashed,
+		})
+	}
+	return previews
+}
+
+// currentCounts is what a restore would replace (compared against the selected snapshot)
+func (m Model) currentCounts() ui.BackupPreview {
+	return ui.BackupPreview{
+		Name:     "current",
+		Taken:    time.Now(),
+		Readable: true,
+		Entries:  len(m.entries),
+		Todos:    len(m.todos),
+		Archived: len(m.archivedTodos),
+		Trashed:  len(m.trashedTodos),
+	}
+}
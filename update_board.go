@@ -0,0 +1,138 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// boardColumns returns the board columns for the current filters (same as the UI)
+func (m Model) boardColumns() []helpers.BoardColumn {
+	return helpers.GroupTodosByStatus(m.filteredDisplayTodos())
+}
+
+// handleBoardKeys processes keyboard input (todo board view)
+func (m Model) handleBoardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	columns := m.boardColumns()
+
+	// Clamp selection after reloads or filter changes
+	m.boardColumn = helpers.ClampBoardSelection(columns, m.boardColumn, m.boardRows)
+	column := columns[m.boardColumn]
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entry list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Back to the single-column todo list (filters are kept)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "/":
+		// Open unified filter input (or clear all filters if already filtering)
+		if len(m.filterTags) > 0 || len(m.filterPeople) > 0 || len(m.filterText) > 0 || m.filterDate != "" {
+			// Clear all filters
+			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
+			m.filterDate = ""
+			m.boardRows = map[string]int{}
+			m.statusMsg = ""
+			return m, nil
+		}
+		// Open unified filter
+		m.filterContext = "board"
+		m.availableTags = helpers.BuildTagTree(m.overviewEntries(), m.overviewTodos(m.todos))
+		m.availablePeople = helpers.ExtractUniquePeopleFromAll(m.overviewEntries(), m.overviewTodos(m.todos))
+		m.unifiedFilterInput.Reset()
+		m.unifiedFilterInput.Focus()
+		m.autocompleteTag = ""
+		m.view = "unified_filter"
+		m.statusMsg = ""
+		return m, textarea.Blink
+	case "h", "left":
+		if m.boardColumn > 0 {
+			m.boardColumn--
+		}
+		return m, nil
+	case "l", "right":
+		if m.boardColumn < len(columns)-1 {
+			m.boardColumn++
+		}
+		return m, nil
+	case "j", "down":
+		if m.boardRows[column.Status] < len(column.Todos)-1 {
+			m.boardRows[column.Status]++
+		}
+		return m, nil
+	case "k", "up":
+		if m.boardRows[column.Status] > 0 {
+			m.boardRows[column.Status]--
+		}
+		return m, nil
+	case "r":
+		// Refresh - reload todos to re-sort
+		return m, m.loadTodos()
+	case "H", "L":
+		// Move selected card to the neighboring column (save immediately)
+		row := m.boardRows[column.Status]
+		if row < 0 || row >= len(column.Todos) {
+			return m, nil
+		}
+		target := m.boardColumn - 1
+		if msg.String() == "L" {
+			target = m.boardColumn + 1
+		}
+		if target < 0 || target >= len(columns) {
+			return m, nil
+		}
+
+		todo := column.Todos[row]
+		before := todo
+		todo.Status = columns[target].Status
+
+		// Track completion time for stats (cleared when reopened)
+		todo.CompletedAt = completionTime(todo.Status)
+
+		m = m.replaceTodo(todo)
+
+		// Follow the card into its new column, found by status: moving the last card
+		// out of a custom column removes that column, shifting the ones after it
+		columns = m.boardColumns()
+		m.boardColumn = helpers.BoardColumnIndex(columns, todo.Status)
+		for i, moved := range columns[m.boardColumn].Todos {
+			if moved.ID == todo.ID {
+				m.boardRows[todo.Status] = i
+				break
+			}
+		}
+		m.boardColumn = helpers.ClampBoardSelection(columns, m.boardColumn, m.boardRows)
+
+		m.statusMsg = "→ " + todo.Status
+		return m.saveTodoEdit(before, todo, "\""+todo.Title+"\" → "+todo.Status)
+	}
+	return m, nil
+}
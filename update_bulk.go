@@ -0,0 +1,63 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bulkTargets returns the IDs a bulk action applies to: marked todos visible under the
+// current filter, or the selected todo when nothing visible is marked
+func (m Model) bulkTargets() map[string]bool {
+	filtered := m.filteredDisplayTodos()
+
+	targets := make(map[string]bool)
+	for _, todo := range filtered {
+		if m.markedTodos[todo.ID] {
+			targets[todo.ID] = true
+		}
+	}
+
+	if len(targets) == 0 && m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
+		targets[filtered[m.selectedTodo].ID] = true
+	}
+
+	return targets
+}
+
+// handleBulkKeys processes keyboard input (bulk command input for marked todos)
+func (m Model) handleBulkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Cancel and return to todos list (marks are kept)
+		m.bulkInput.Blur()
+		m.view = "todos"
+		m.statusMsg = ""
+		return m, nil
+	case "enter":
+		action, err := helpers.ParseBulkCommand(m.bulkInput.Value(), time.Now())
+		if err != nil {
+			m.statusMsg = err.Error() + ". Try: " + helpers.BulkCommandHint
+			return m, nil
+		}
+
+		// New tags go through the alias table like typed @mentions
+		if action.Kind == "add_tag" || action.Kind == "remove_tag" {
+			action.Tag = helpers.ApplyTagAliases([]string{action.Tag}, m.config.TagAliases)[0]
+		}
+
+		m.bulkInput.Blur()
+		m.view = "todos"
+		m.statusMsg = ""
+		return m, m.applyBulkAction(m.bulkTargets(), action)
+	}
+
+	// Let all other keys pass through to textarea
+	m.bulkInput, cmd = m.bulkInput.Update(msg)
+	return m, cmd
+}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -9,6 +12,12 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
 	case "n":
 		// Create new entry (using shared helper)
 		return m.handleNewEntry()
@@ -22,9 +31,70 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.view = "todos"
 		m.selectedTodo = 0
 		return m, m.loadEntriesAndTodos()
+	case "g":
+		// Agenda: what should I do today?
+		m.view = "agenda"
+		m.selectedAgenda = 0
+		return m, m.loadEntriesAndTodos()
+	case "b":
+		// Todo board (one column per status)
+		m.view = "board"
+		m.boardColumn = 0
+		m.boardRows = map[string]int{}
+		return m, m.loadEntriesAndTodos()
 	case "a":
 		// Add standalone todo (using shared helper)
 		return m.handleAddTodo()
+	case "s":
+		// Stats view (load entries, todos and the archive: completed work counts from both)
+		m.view = "stats"
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadArchive())
+	case "r":
+		// Weekly review for the current ISO week (load entries, todos and the archive)
+		m.view = "review"
+		m.reviewYear, m.reviewWeek = helpers.GetISOWeek(time.Now())
+		m.scrollOffset = 0
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadArchive())
+	case "z":
+		// Archive browser (archive.json is only read here)
+		m.view = "archive"
+		m.selectedArchived = 0
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadArchive())
+	case "T":
+		// Timesheet for the current ISO week (tracked time may be on archived todos too)
+		m.view = "timesheet"
+		m.timesheetYear, m.timesheetWeek = helpers.GetISOWeek(time.Now())
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadArchive())
+	case "D":
+		// Trash (deleted todos, trash.json is only read here)
+		m.view = "trash"
+		m.selectedTrashed = 0
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadTrash())
+	case "B":
+		// Backups (snapshots listed with what they hold; archive and trash loaded for the comparison)
+		m.view = "backups"
+		m.selectedSnapshot = 0
+		m.confirmingRestore = ""
+		return m, tea.Batch(m.loadEntriesAndTodos(), m.loadArchive(), m.loadTrash(), m.loadBackups())
+	case "p":
+		// People view (load both entries and todos for mentions)
+		m.view = "people"
+		m.selectedPerson = 0
+		return m, m.loadEntriesAndTodos()
+	case "@":
+		// Tag management (load both entries and todos for usage counts)
+		m.view = "tags"
+		m.selectedTag = 0
+		m.renamingTag = ""
+		return m, m.loadEntriesAndTodos()
+	case "v":
+		// Switch activity section between line graph and year heatmap
+		if m.dashboardMode == "heatmap" {
+			m.dashboardMode = "graph"
+		} else {
+			m.dashboardMode = "heatmap"
+		}
+		return m, nil
 	case "esc":
 		m.view = "dashboard"
 	}
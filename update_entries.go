@@ -11,6 +11,12 @@ func (m Model) handleEntriesListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
 	case "esc":
 		// Go back to dashboard
 		m.view = "dashboard"
@@ -19,6 +25,9 @@ func (m Model) handleEntriesListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "n":
 		// Create new entry (using shared helper)
 		return m.handleNewEntry()
+	case "p":
+		// Reveal or hide private entries
+		return m.togglePrivate()
 	case "t":
 		// Jump to todo list (explicit navigation)
 		m.view = "todos"
@@ -32,16 +41,19 @@ func (m Model) handleEntriesListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleAddTodo()
 	case "/":
 		// Open unified filter input (or clear all filters if already filtering)
-		if len(m.filterTags) > 0 || m.filterDate != "" {
+		if len(m.filterTags) > 0 || len(m.filterPeople) > 0 || len(m.filterText) > 0 || m.filterDate != "" {
 			// Clear all filters
 			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
 			m.filterDate = ""
 			m.statusMsg = ""
 			return m, nil
 		}
 		// Open unified filter
 		m.filterContext = "entries"
-		m.availableTags = helpers.ExtractUniqueTagsFromAll(m.entries, m.todos)
+		m.availableTags = helpers.BuildTagTree(m.overviewEntries(), m.overviewTodos(m.todos))
+		m.availablePeople = helpers.ExtractUniquePeopleFromAll(m.overviewEntries(), m.overviewTodos(m.todos))
 		m.unifiedFilterInput.Reset()
 		m.unifiedFilterInput.Focus()
 		m.autocompleteTag = ""
@@ -49,11 +61,8 @@ func (m Model) handleEntriesListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.statusMsg = ""
 		return m, textarea.Blink
 	case "j", "down":
-		// Apply filters to get displayed list
-		filtered := helpers.FilterEntriesByDateRange(m.entries, m.filterDate)
-		filtered = helpers.FilterEntriesByTags(filtered, m.filterTags)
-
-		if m.selectedEntry < len(filtered)-1 {
+		// Displayed list (cached, same as UI)
+		if m.selectedEntry < len(m.filteredDisplayEntries())-1 {
 			m.selectedEntry++
 		}
 		return m, nil
@@ -64,13 +73,9 @@ func (m Model) handleEntriesListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case "enter":
 		// Open selected entry for read-only viewing
-		// Apply filters (same logic as UI)
-		filtered := helpers.FilterEntriesByDateRange(m.entries, m.filterDate)
-		filtered = helpers.FilterEntriesByTags(filtered, m.filterTags)
-
-		if m.selectedEntry >= 0 && m.selectedEntry < len(filtered) {
-			// Need to get the sorted entry (newest first)
-			sorted := helpers.SortEntriesForDisplay(filtered)
+		// Displayed list, filtered and sorted newest first (same as UI)
+		sorted := m.filteredDisplayEntries()
+		if m.selectedEntry >= 0 && m.selectedEntry < len(sorted) {
 			m.viewingEntry = sorted[m.selectedEntry]
 			m.scrollOffset = 0 // Reset scroll when opening entry
 			m.view = "view_entry"
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"github.com/apodacaa/amos/internal/helpers"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -10,6 +9,12 @@ func (m Model) handleViewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
 	case "esc":
 		// Go back to dashboard
 		m.view = "dashboard"
@@ -18,6 +23,9 @@ func (m Model) handleViewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "n":
 		// Create new entry (using shared helper)
 		return m.handleNewEntry()
+	case "p":
+		// Reveal or hide private entries
+		return m.togglePrivate()
 	case "a":
 		// Add standalone todo (using shared helper)
 		return m.handleAddTodo()
@@ -35,20 +43,11 @@ func (m Model) handleViewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, m.loadEntriesAndTodos()
 	case "j", "down":
 		// Navigate to next entry (newer to older, same as entry list)
-		// Apply filters and sort (same as entry list view)
-		filtered := helpers.FilterEntriesByDateRange(m.entries, m.filterDate)
-		filtered = helpers.FilterEntriesByTags(filtered, m.filterTags)
-		sorted := helpers.SortEntriesForDisplay(filtered)
+		// Filtered and sorted list (cached, same as entry list view)
+		sorted := m.filteredDisplayEntries()
 
 		if len(sorted) > 0 {
-			// Find current entry index in sorted list
-			currentIdx := -1
-			for i, entry := range sorted {
-				if entry.ID == m.viewingEntry.ID {
-					currentIdx = i
-					break
-				}
-			}
+			currentIdx := m.entryList.IndexOf(m.viewingEntry.ID)
 
 			// Move down (to next entry, which is older)
 			if currentIdx >= 0 && currentIdx < len(sorted)-1 {
@@ -60,20 +59,11 @@ func (m Model) handleViewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case "k", "up":
 		// Navigate to previous entry (older to newer, same as entry list)
-		// Apply filters and sort (same as entry list view)
-		filtered := helpers.FilterEntriesByDateRange(m.entries, m.filterDate)
-		filtered = helpers.FilterEntriesByTags(filtered, m.filterTags)
-		sorted := helpers.SortEntriesForDisplay(filtered)
+		// Filtered and sorted list (cached, same as entry list view)
+		sorted := m.filteredDisplayEntries()
 
 		if len(sorted) > 0 {
-			// Find current entry index in sorted list
-			currentIdx := -1
-			for i, entry := range sorted {
-				if entry.ID == m.viewingEntry.ID {
-					currentIdx = i
-					break
-				}
-			}
+			currentIdx := m.entryList.IndexOf(m.viewingEntry.ID)
 
 			// Move up (to previous entry, which is newer)
 			if currentIdx > 0 {
@@ -83,12 +73,12 @@ func (m Model) handleViewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return m, nil
-	case "u":
-		// Scroll down in current entry (u is above j, j goes down)
+	case "J":
+		// Scroll down in current entry (shift+j; j moves between entries)
 		m.scrollOffset++
 		return m, nil
-	case "i":
-		// Scroll up in current entry (i is above k, k goes up)
+	case "K":
+		// Scroll up in current entry (shift+k)
 		if m.scrollOffset > 0 {
 			m.scrollOffset--
 		}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// startFocus opens focus mode on a "next" todo with a fresh work interval
+func (m Model) startFocus(todo models.Todo) (Model, tea.Cmd) {
+	if todo.Status != "next" {
+		m.statusMsg = "focus mode works on \"next\" todos (space to cycle)"
+		m.statusTime = time.Now()
+		return m, clearStatusAfterDelay()
+	}
+
+	work, _ := helpers.PomodoroLengths(m.config)
+	m.focusReturnView = m.view
+	m.focusTodoID = todo.ID
+	m.focusPhase = "work"
+	m.focusEnds = time.Now().Add(work)
+	m.focusSession++ // Ticks from an earlier session are ignored
+	m.view = "focus"
+	m.statusMsg = ""
+	return m, focusTick(m.focusSession)
+}
+
+// focusTodo returns the todo focus mode is bound to (looked up by ID so undo/reloads stay in sync)
+func (m Model) focusTodo() (models.Todo, bool) {
+	for _, todo := range m.todos {
+		if todo.ID == m.focusTodoID {
+			return todo, true
+		}
+	}
+	return models.Todo{}, false
+}
+
+// handleFocusKeys processes keyboard input (focus mode)
+func (m Model) handleFocusKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Leave focus mode (an unfinished work interval is not recorded)
+		m.focusSession++
+		m.view = m.focusReturnView
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "s":
+		// Skip to the next interval (a skipped work interval is not recorded)
+		return m.nextFocusPhase(time.Now(), false)
+	}
+	return m, nil
+}
+
+// handleFocusTick advances the countdown and switches phase when an interval ends
+func (m Model) handleFocusTick(msg focusTickMsg) (Model, tea.Cmd) {
+	if msg.session != m.focusSession || m.view != "focus" {
+		return m, nil // Focus mode was left (or restarted) since this tick was scheduled
+	}
+
+	now := time.Now()
+	if now.Before(m.focusEnds) {
+		return m, focusTick(m.focusSession)
+	}
+	return m.nextFocusPhase(now, true)
+}
+
+// nextFocusPhase switches between work and break; a completed work interval is recorded on the todo
+func (m Model) nextFocusPhase(now time.Time, completed bool) (Model, tea.Cmd) {
+	work, rest := helpers.PomodoroLengths(m.config)
+	cmds := []tea.Cmd{focusTick(m.focusSession)}
+	if completed {
+		cmds = append(cmds, ringBell())
+	}
+
+	if m.focusPhase == "work" {
+		if todo, found := m.focusTodo(); found && completed {
+			after := todo
+			after.Pomodoros = append(append([]time.Time{}, todo.Pomodoros...), now)
+			var cmd tea.Cmd
+			m, cmd = m.saveTodoEdit(todo, after, "pomodoro \""+todo.Title+"\"")
+			cmds = append(cmds, cmd)
+			m.statusMsg = fmt.Sprintf("pomodoro done, %d min break", int(rest.Minutes()))
+		}
+		m.focusPhase = "break"
+		m.focusEnds = now.Add(rest)
+	} else {
+		m.focusPhase = "work"
+		m.focusEnds = now.Add(work)
+		if completed {
+			m.statusMsg = "break over"
+			m.statusTime = now
+			cmds = append(cmds, clearStatusAfterDelay())
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// focusTick redraws the focus countdown once a second (same tea.Tick pattern as clearStatusAfterDelay)
+func focusTick(session int) tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return focusTickMsg{session: session}
+	})
+}
+
+// ringBell rings the terminal bell (stderr, so the alt-screen renderer's output is left alone)
+func ringBell() tea.Cmd {
+	return func() tea.Msg {
+		fmt.Fprint(os.Stderr, "\a")
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handlePeopleKeys processes keyboard input (people view)
+func (m Model) handlePeopleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	people := helpers.SummarizePeople(m.overviewEntries(), m.overviewTodos(m.todos))
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "j", "down":
+		if m.selectedPerson < len(people)-1 {
+			m.selectedPerson++
+		}
+		return m, nil
+	case "k", "up":
+		if m.selectedPerson > 0 {
+			m.selectedPerson--
+		}
+		return m, nil
+	case "enter":
+		// Show todos involving the selected person (replaces any active filters)
+		if m.selectedPerson >= 0 && m.selectedPerson < len(people) {
+			m.filterTags = []string{}
+			m.filterDate = ""
+			m.filterText = []string{}
+			m.filterPeople = []string{"+" + people[m.selectedPerson].Name}
+			m.view = "todos"
+			m.selectedTodo = 0
+			m.statusMsg = ""
+			return m, m.loadEntriesAndTodos()
+		}
+		return m, nil
+	}
+	return m, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// togglePrivate reveals or hides private entries (p in the entries list, entry view and review)
+func (m Model) togglePrivate() (tea.Model, tea.Cmd) {
+	m.revealPrivate = !m.revealPrivate
+	m.statusMsg = "private entries hidden"
+	if m.revealPrivate {
+		m.statusMsg = "private entries shown"
+	}
+	m.statusTime = time.Now()
+	return m, clearStatusAfterDelay()
+}
+
+// overviewEntries returns entries for the dashboard, stats and review: private ones are left out until revealed
+func (m Model) overviewEntries() []models.Entry {
+	if m.revealPrivate {
+		return m.entries
+	}
+	return helpers.WithoutPrivateEntries(m.entries)
+}
+
+// reportTodos returns active plus archived todos for the review and timesheet:
+// todos written in private entries are left out until revealed
+func (m Model) reportTodos() []models.Todo {
+	if m.revealPrivate {
+		return m.historyTodos()
+	}
+	return helpers.WithoutPrivateTodos(m.historyTodos(), m.entries)
+}
+
+// overviewTodos returns todos with those written in private entries left out until revealed
+// (people and tags screens, tag and person autocomplete)
+func (m Model) overviewTodos(todos []models.Todo) []models.Todo {
+	if m.revealPrivate {
+		return todos
+	}
+	return helpers.WithoutPrivateTodos(todos, m.entries)
+}
+
+// screenEntries returns entries with private ones blanked until revealed (agenda, todo detail)
+func (m Model) screenEntries() []models.Entry {
+	if m.revealPrivate {
+		return m.entries
+	}
+	return helpers.RedactPrivateEntries(m.entries)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// listFilter is the current unified filter (/), shared by the entry and todo lists
+func (m Model) listFilter() helpers.ListFilter {
+	return helpers.ListFilter{Tags: m.filterTags, People: m.filterPeople, Text: m.filterText, Date: m.filterDate}
+}
+
+// filteredDisplayEntries returns entries with the current filters applied, newest first (same as the UI)
+// Cached: only rebuilt when entries or the filters change
+func (m Model) filteredDisplayEntries() []models.Entry {
+	return m.entryList.Items(m.entries, m.listFilter(), time.Now())
+}
+
+// applyExternalEntries swaps in entries another process changed, keeping the selected entry selected
+func (m Model) applyExternalEntries(entries []models.Entry) (tea.Model, tea.Cmd) {
+	selectedID := ""
+	if list := m.filteredDisplayEntries(); m.selectedEntry >= 0 && m.selectedEntry < len(list) {
+		selectedID = list[m.selectedEntry].ID
+	}
+
+	// Saving the open editor would replace the version on disk
+	conflict := m.view == "entry" && m.hasUnsaved && helpers.EntryChangedOnDisk(m.entries, entries, m.currentEntry.ID)
+
+	m.entries = entries
+	list := m.filteredDisplayEntries()
+	m.selectedEntry = selectionAfterReload(m.entryList.IndexOf(selectedID), m.selectedEntry, len(list))
+
+	// The entry view shows a copy
+	if m.view == "view_entry" {
+		if i := helpers.EntryIndexByID(entries, m.viewingEntry.ID); i >= 0 {
+			m.viewingEntry = entries[i]
+		}
+	}
+
+	if conflict {
+		return m.reloadConflict("⚠ This entry changed on disk: saving will overwrite it")
+	}
+	return m.reloadNotice("entries changed on disk, reloaded")
+}
+
+// applyExternalTodos swaps in todos another process changed, keeping the selected todo selected
+func (m Model) applyExternalTodos(todos []models.Todo) (tea.Model, tea.Cmd) {
+	selectedID := ""
+	if list := m.filteredDisplayTodos(); m.selectedTodo >= 0 && m.selectedTodo < len(list) {
+		selectedID = list[m.selectedTodo].ID
+	}
+
+	// Saving the open title/tags/notes edit would replace the version on disk
+	conflict := m.view == "todo_detail" && m.detailEditing && helpers.TodoChangedOnDisk(m.todos, todos, m.detailTodoID)
+
+	m.todos = todos
+	m.displayTodos = helpers.SortTodosForDisplay(m.todos)
+	list := m.filteredDisplayTodos()
+	m.selectedTodo = selectionAfterReload(m.todoList.IndexOf(selectedID), m.selectedTodo, len(list))
+
+	// Marks on todos that are gone would hit nothing
+	for id := range m.markedTodos {
+		if helpers.TodoIndexByID(m.todos, id) < 0 {
+			delete(m.markedTodos, id)
+		}
+	}
+
+	var cmds []tea.Cmd
+	// A timer started elsewhere ticks in the header too
+	if _, running := helpers.RunningTimer(m.todos); running && !m.timerTicking {
+		m.timerTicking = true
+		cmds = append(cmds, timerTick())
+	}
+
+	if conflict {
+		m, cmd := m.reloadConflict("⚠ This todo changed on disk: saving will overwrite it")
+		return m, tea.Batch(append(cmds, cmd)...)
+	}
+	m, cmd := m.reloadNotice("todos changed on disk, reloaded")
+	return m, tea.Batch(append(cmds, cmd)...)
+}
+
+// selectionAfterReload returns the new index of the selected item (found = its index in the reloaded list, -1 if gone)
+// A vanished item leaves the cursor where it was, kept within the list
+func selectionAfterReload(found, previous, length int) int {
+	if found >= 0 {
+		return found
+	}
+	if previous >= length {
+		previous = length - 1
+	}
+	if previous < 0 {
+		previous = 0
+	}
+	return previous
+}
+
+// reloadConflict shows a warning that stays until the next status message
+func (m Model) reloadConflict(warning string) (Model, tea.Cmd) {
+	m.statusMsg = warning
+	m.statusTime = time.Now()
+	return m, nil
+}
+
+// reloadNotice tells the user a reload happened, unless another message is showing
+func (m Model) reloadNotice(notice string) (Model, tea.Cmd) {
+	if m.statusMsg != "" {
+		return m, nil
+	}
+	m.statusMsg = notice
+	m.statusTime = time.Now()
+	return m, clearStatusAfterDelay()
+}
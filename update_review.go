@@ -0,0 +1,64 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleReviewKeys processes keyboard input (weekly review)
+func (m Model) handleReviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "h", "left":
+		// Previous ISO week
+		m.reviewYear, m.reviewWeek = helpers.ShiftISOWeek(m.reviewYear, m.reviewWeek, -1)
+		m.scrollOffset = 0
+		return m, nil
+	case "l", "right":
+		// Next ISO week
+		m.reviewYear, m.reviewWeek = helpers.ShiftISOWeek(m.reviewYear, m.reviewWeek, 1)
+		m.scrollOffset = 0
+		return m, nil
+	case "J":
+		// Scroll down (same keys as entry view)
+		m.scrollOffset++
+		return m, nil
+	case "K":
+		// Scroll up
+		if m.scrollOffset > 0 {
+			m.scrollOffset--
+		}
+		return m, nil
+	case "p":
+		// Include or leave out private entries (and their todos), on screen and in the export
+		return m.togglePrivate()
+	case "x":
+		// Export as Markdown to ~/.amos/reviews/<week>.md
+		return m, m.saveReview()
+	}
+	return m, nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleStatsKeys processes keyboard input (stats view)
+func (m Model) handleStatsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "w":
+		// Cycle window: 4 → 13 → 52 → 4 weeks
+		m.statsWeeks = nextStatsWindow(m.statsWeeks)
+		return m, nil
+	}
+	return m, nil
+}
+
+// nextStatsWindow returns the window after current in helpers.StatsWindowWeeks (wrapping around)
+func nextStatsWindow(current int) int {
+	windows := helpers.StatsWindowWeeks
+	for i, weeks := range windows {
+		if weeks == current {
+			return windows[(i+1)%len(windows)]
+		}
+	}
+	return windows[0]
+}
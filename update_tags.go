@@ -0,0 +1,111 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleTagsKeys processes keyboard input (tag management view)
+func (m Model) handleTagsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Rename/merge input captures all keys while open
+	if m.renamingTag != "" {
+		return m.handleTagRenameKeys(msg)
+	}
+
+	tagStats := helpers.CountTagUsage(m.overviewEntries(), m.overviewTodos(m.todos))
+
+	// Keep selection in range after a merge shrinks the list
+	if m.selectedTag >= len(tagStats) {
+		m.selectedTag = max(len(tagStats)-1, 0)
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "j", "down":
+		if m.selectedTag < len(tagStats)-1 {
+			m.selectedTag++
+		}
+		return m, nil
+	case "k", "up":
+		if m.selectedTag > 0 {
+			m.selectedTag--
+		}
+		return m, nil
+	case "r":
+		// Rename or merge selected tag (prefill input with current name)
+		if m.selectedTag >= 0 && m.selectedTag < len(tagStats) {
+			tag, _ := helpers.NormalizeTagName(tagStats[m.selectedTag].Tag)
+			m.renamingTag = tag
+			m.tagInput.Reset()
+			m.tagInput.SetValue(tag)
+			m.tagInput.Focus()
+			m.statusMsg = ""
+			return m, textarea.Blink
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+// handleTagRenameKeys processes keyboard input (rename/merge target input)
+func (m Model) handleTagRenameKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Cancel rename, stay in tag list
+		m.renamingTag = ""
+		m.tagInput.Blur()
+		m.statusMsg = ""
+		return m, nil
+	case "enter":
+		newTag, ok := helpers.NormalizeTagName(m.tagInput.Value())
+		if !ok {
+			m.statusMsg = "⚠ Tags use letters, numbers, - and _ (nested with /)"
+			return m, nil
+		}
+		if newTag == m.renamingTag {
+			m.statusMsg = "⚠ New name matches current name"
+			return m, nil
+		}
+		if helpers.TagMatches(newTag, m.renamingTag) {
+			m.statusMsg = "⚠ Cannot move a tag under itself"
+			return m, nil
+		}
+
+		oldTag := m.renamingTag
+		m.renamingTag = ""
+		m.tagInput.Blur()
+		return m, m.renameTag(oldTag, newTag)
+	default:
+		// Let all other keys pass through to textarea
+		var cmd tea.Cmd
+		m.tagInput, cmd = m.tagInput.Update(msg)
+		return m, cmd
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/internal/storage"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// toggleTimer starts or stops the timer on todo (undoable)
+// Only one timer runs at a time: starting one stops the running one first
+func (m Model) toggleTimer(todo models.Todo) (Model, tea.Cmd) {
+	now := time.Now()
+	record := models.UndoRecord{At: now}
+	var changed []models.Todo
+
+	if helpers.TimerRunning(todo) {
+		after := helpers.StopTimer(todo, now)
+		record.Label = "stop timer \"" + todo.Title + "\""
+		record.Todos = append(record.Todos, todoChange(todo, after))
+		changed = append(changed, after)
+		m.statusMsg = "timer stopped: " + helpers.FormatDuration(helpers.TrackedTime(after, now)) + " on " + todo.Title
+	} else {
+		if running, ok := helpers.RunningTimer(m.todos); ok {
+			stopped := helpers.StopTimer(running, now)
+			record.Todos = append(record.Todos, todoChange(running, stopped))
+			changed = append(changed, stopped)
+		}
+		after := helpers.StartTimer(todo, now)
+		record.Label = "start timer \"" + todo.Title + "\""
+		record.Todos = append(record.Todos, todoChange(todo, after))
+		changed = append(changed, after)
+		m.statusMsg = "timer started: " + todo.Title
+	}
+
+	for _, t := range changed {
+		m = m.replaceTodo(t)
+	}
+	m.undoLog = helpers.PushUndo(m.undoLog, record)
+	m.statusTime = now
+
+	cmds := []tea.Cmd{saveTodosImmediate(changed), saveUndoLog(m.undoLog), clearStatusAfterDelay()}
+	if !m.timerTicking {
+		m.timerTicking = true
+		cmds = append(cmds, timerTick())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// todoChange builds the undo change for an edit of one todo in todos.json
+func todoChange(before, after models.Todo) models.TodoChange {
+	return models.TodoChange{ID: before.ID, Before: &before, After: &after}
+}
+
+// saveTodosImmediate saves edited todos (timer start/stop may touch two) in one locked write
+func saveTodosImmediate(todos []models.Todo) tea.Cmd {
+	return func() tea.Msg {
+		err := storage.UpdateTodos(func(stored []models.Todo) ([]models.Todo, error) {
+			for _, todo := range todos {
+				if i := helpers.TodoIndexByID(stored, todo.ID); i >= 0 {
+					stored[i] = todo
+				} else {
+					stored = append(stored, todo)
+				}
+			}
+			return stored, nil
+		})
+		return todoToggledMsg{err: err}
+	}
+}
+
+// timerTick redraws once a second while a timer runs (header clock)
+func timerTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return timerTickMsg{}
+	})
+}
+
+// timerHeaderStatus is the header text for the running timer: current session clock and title ("" when none runs)
+func (m Model) timerHeaderStatus() string {
+	running, ok := helpers.RunningTimer(m.todos)
+	if !ok {
+		return ""
+	}
+	title := running.Title
+	if runes := []rune(title); len(runes) > 24 {
+		title = string(runes[:21]) + "..." // Cut on runes: a byte cut can split a multi-byte character
+	}
+	return "timer " + helpers.FormatTimerClock(helpers.RunningFor(running, time.Now())) + " " + title
+}
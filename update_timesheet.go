@@ -0,0 +1,64 @@
+package main
+
+import (
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/storage"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleTimesheetKeys processes keyboard input (timesheet)
+func (m Model) handleTimesheetKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Go back to dashboard
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entries list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "h", "left":
+		// Previous ISO week
+		m.timesheetYear, m.timesheetWeek = helpers.ShiftISOWeek(m.timesheetYear, m.timesheetWeek, -1)
+		return m, nil
+	case "l", "right":
+		// Next ISO week
+		m.timesheetYear, m.timesheetWeek = helpers.ShiftISOWeek(m.timesheetYear, m.timesheetWeek, 1)
+		return m, nil
+	case "p":
+		// Include or leave out time on todos from private entries
+		return m.togglePrivate()
+	case "x":
+		// Export as CSV to ~/.amos/timesheets/<week>.csv
+		return m, m.saveTimesheet()
+	}
+	return m, nil
+}
+
+// saveTimesheet exports the timesheet shown in the TUI to ~/.amos/timesheets
+func (m Model) saveTimesheet() tea.Cmd {
+	sheet := helpers.BuildTimesheet(m.reportTodos(), m.timesheetYear, m.timesheetWeek, time.Local, time.Now())
+	return func() tea.Msg {
+		path, err := storage.SaveTimesheet(helpers.WeekID(sheet.Year, sheet.Week), helpers.FormatTimesheetCSV(sheet))
+		return timesheetSavedMsg{path: path, err: err}
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/apodacaa/amos/ui"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// detailTodo returns the todo shown in the detail view (looked up by ID so undo/reloads stay in sync)
+func (m Model) detailTodo() (models.Todo, bool) {
+	for _, todo := range m.todos {
+		if todo.ID == m.detailTodoID {
+			return todo, true
+		}
+	}
+	return models.Todo{}, false
+}
+
+// detailEntry returns the entry a todo was extracted from (nil if standalone or missing)
+func (m Model) detailEntry(todo models.Todo) *models.Entry {
+	if todo.EntryID == nil {
+		return nil
+	}
+	for i := range m.entries {
+		if m.entries[i].ID == *todo.EntryID {
+			return &m.entries[i]
+		}
+	}
+	return nil
+}
+
+// handleTodoDetailKeys processes keyboard input (todo detail view)
+func (m Model) handleTodoDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.detailEditing {
+		return m.handleTodoDetailEditKeys(msg)
+	}
+
+	todo, found := m.detailTodo()
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
+	case "esc":
+		// Back to todos list (selection is kept)
+		m.view = "todos"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entry list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Back to todos list
+		m.view = "todos"
+		m.statusMsg = ""
+		return m, nil
+	case "j", "down":
+		if m.detailField < len(ui.TodoDetailFields)-1 {
+			m.detailField++
+		}
+		return m, nil
+	case "k", "up":
+		if m.detailField > 0 {
+			m.detailField--
+		}
+		return m, nil
+	case " ":
+		// Cycle status (same as the todos list)
+		if !found {
+			return m, nil
+		}
+		return m.cycleTodoStatus(todo)
+	case "enter":
+		if !found {
+			return m, nil
+		}
+		// Status cycles in place; title and tags open the input, notes the multi-line editor
+		field := ui.TodoDetailFields[m.detailField]
+		if field == "status" {
+			return m.cycleTodoStatus(todo)
+		}
+		if field == "notes" {
+			m.notesInput.Reset()
+			m.notesInput.SetValue(todo.Notes)
+			m.notesInput.Focus()
+			m.detailEditing = true
+			m.confirmingExit = false
+			m.statusMsg = ""
+			return m, textarea.Blink
+		}
+		m.detailInput.Reset()
+		if field == "title" {
+			m.detailInput.SetValue(todo.Title)
+		} else {
+			m.detailInput.SetValue(helpers.FormatTagList(todo.Tags))
+		}
+		m.detailInput.Focus()
+		m.detailEditing = true
+		m.statusMsg = ""
+		return m, textarea.Blink
+	case "s":
+		// Start/stop the timer on this todo
+		if !found {
+			return m, nil
+		}
+		return m.toggleTimer(todo)
+	case "o":
+		// Open the entry this todo came from
+		if !found {
+			return m, nil
+		}
+		if todo.EntryID == nil {
+			m.statusMsg = "standalone todo (no entry)"
+			m.statusTime = time.Now()
+			return m, clearStatusAfterDelay()
+		}
+		entry := m.detailEntry(todo)
+		if entry == nil {
+			m.statusMsg = "linked entry not found"
+			m.statusTime = time.Now()
+			return m, clearStatusAfterDelay()
+		}
+		m.viewingEntry = *entry
+		m.scrollOffset = 0
+		m.view = "view_entry"
+		m.statusMsg = ""
+		return m, nil
+	}
+	return m, nil
+}
+
+// handleTodoDetailEditKeys processes keyboard input while editing the title or tags
+func (m Model) handleTodoDetailEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if ui.TodoDetailFields[m.detailField] == "notes" {
+		return m.handleTodoNotesKeys(msg)
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Cancel edit (nothing saved)
+		m.detailInput.Blur()
+		m.detailEditing = false
+		m.statusMsg = ""
+		return m, nil
+	case "enter":
+		todo, found := m.detailTodo()
+		if !found {
+			m.detailInput.Blur()
+			m.detailEditing = false
+			return m, nil
+		}
+
+		value := strings.TrimSpace(m.detailInput.Value())
+		title := value
+		if ui.TodoDetailFields[m.detailField] == "tags" {
+			tags, err := helpers.ParseTagList(value)
+			if err != nil {
+				m.statusMsg = "⚠ " + err.Error()
+				return m, nil
+			}
+			title = helpers.SetTitleTags(todo.Title, helpers.ApplyTagAliases(tags, m.config.TagAliases))
+		}
+		if title == "" {
+			m.statusMsg = "⚠ Todo title cannot be empty"
+			return m, nil
+		}
+
+		m.detailInput.Blur()
+		m.detailEditing = false
+		if title == todo.Title {
+			m.statusMsg = ""
+			return m, nil
+		}
+
+		// Tags and people always follow the title
+		edited := helpers.RetitleTodo(todo, title, m.config.TagAliases)
+		m.statusMsg = "saved"
+		return m.saveTodoEdit(todo, edited, "edit \""+edited.Title+"\"")
+	}
+
+	// Let all other keys pass through to textarea
+	m.detailInput, cmd = m.detailInput.Update(msg)
+	return m, cmd
+}
+
+// handleTodoNotesKeys processes keyboard input while editing notes (enter is a newline, ctrl+s saves)
+func (m Model) handleTodoNotesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	todo, found := m.detailTodo()
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Confirm before discarding changed notes (same as the entry form)
+		if found && !m.confirmingExit && m.notesInput.Value() != todo.Notes {
+			m.confirmingExit = true
+			m.statusMsg = "⚠ Unsaved changes! Press Esc again to discard, or Ctrl+S to save"
+			return m, nil
+		}
+		m.notesInput.Blur()
+		m.detailEditing = false
+		m.confirmingExit = false
+		m.statusMsg = ""
+		return m, nil
+	case "ctrl+s":
+		m.notesInput.Blur()
+		m.detailEditing = false
+		m.confirmingExit = false
+		if !found {
+			return m, nil
+		}
+
+		notes := strings.TrimRight(m.notesInput.Value(), " \t\n")
+		if notes == todo.Notes {
+			m.statusMsg = ""
+			return m, nil
+		}
+
+		edited := todo
+		edited.Notes = notes
+		m.statusMsg = "saved"
+		return m.saveTodoEdit(todo, edited, "edit notes \""+todo.Title+"\"")
+	}
+
+	// If confirming exit and user keeps typing, cancel confirmation
+	if m.confirmingExit {
+		m.confirmingExit = false
+		m.statusMsg = ""
+	}
+
+	// Let all other keys pass through to textarea
+	m.notesInput, cmd = m.notesInput.Update(msg)
+	return m, cmd
+}
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"time"
+
 	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -11,8 +14,19 @@ func (m Model) handleTodosListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
 	case "esc":
-		// Go back to dashboard
+		// Clear marks first, then go back to dashboard
+		if len(m.markedTodos) > 0 {
+			m.markedTodos = map[string]bool{}
+			m.markAnchor = -1
+			return m, nil
+		}
 		m.view = "dashboard"
 		m.statusMsg = "" // Clear status message when changing views
 		return m, nil
@@ -30,16 +44,19 @@ func (m Model) handleTodosListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleAddTodo()
 	case "/":
 		// Open unified filter input (or clear all filters if already filtering)
-		if len(m.filterTags) > 0 || m.filterDate != "" {
+		if len(m.filterTags) > 0 || len(m.filterPeople) > 0 || len(m.filterText) > 0 || m.filterDate != "" {
 			// Clear all filters
 			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
 			m.filterDate = ""
 			m.statusMsg = ""
 			return m, nil
 		}
 		// Open unified filter
 		m.filterContext = "todos"
-		m.availableTags = helpers.ExtractUniqueTagsFromAll(m.entries, m.todos)
+		m.availableTags = helpers.BuildTagTree(m.overviewEntries(), m.overviewTodos(m.todos))
+		m.availablePeople = helpers.ExtractUniquePeopleFromAll(m.overviewEntries(), m.overviewTodos(m.todos))
 		m.unifiedFilterInput.Reset()
 		m.unifiedFilterInput.Focus()
 		m.autocompleteTag = ""
@@ -47,11 +64,8 @@ func (m Model) handleTodosListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.statusMsg = ""
 		return m, textarea.Blink
 	case "j", "down":
-		// Apply filters to get the displayed list (same as UI)
-		filtered := helpers.FilterTodosByDateRange(m.displayTodos, m.filterDate)
-		filtered = helpers.FilterTodosByTags(filtered, m.filterTags)
-
-		if m.selectedTodo < len(filtered)-1 {
+		// Displayed list (cached, same as UI)
+		if m.selectedTodo < len(m.filteredDisplayTodos())-1 {
 			m.selectedTodo++
 		}
 		return m, nil
@@ -63,52 +77,209 @@ func (m Model) handleTodosListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "r":
 		// Refresh - reload todos to re-sort
 		return m, m.loadTodos()
+	case "m":
+		// Mark/unmark selected todo for a bulk action, then move down
+		filtered := m.filteredDisplayTodos()
+		if m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
+			id := filtered[m.selectedTodo].ID
+			if m.markedTodos[id] {
+				delete(m.markedTodos, id)
+			} else {
+				m.markedTodos[id] = true
+			}
+			m.markAnchor = m.selectedTodo
+			if m.selectedTodo < len(filtered)-1 {
+				m.selectedTodo++
+			}
+		}
+		return m, nil
+	case "M":
+		// Mark the range from the last mark to the selected todo
+		filtered := m.filteredDisplayTodos()
+		if m.selectedTodo < 0 || m.selectedTodo >= len(filtered) {
+			return m, nil
+		}
+		from, to := m.markAnchor, m.selectedTodo
+		if from < 0 || from >= len(filtered) {
+			from = to
+		}
+		if from > to {
+			from, to = to, from
+		}
+		for i := from; i <= to; i++ {
+			m.markedTodos[filtered[i].ID] = true
+		}
+		m.markAnchor = m.selectedTodo
+		return m, nil
+	case "*":
+		// Mark all todos matching the current filter (or unmark them if all are marked)
+		filtered := m.filteredDisplayTodos()
+		allMarked := len(filtered) > 0
+		for _, todo := range filtered {
+			if !m.markedTodos[todo.ID] {
+				allMarked = false
+				break
+			}
+		}
+		for _, todo := range filtered {
+			if allMarked {
+				delete(m.markedTodos, todo.ID)
+			} else {
+				m.markedTodos[todo.ID] = true
+			}
+		}
+		m.markAnchor = -1
+		return m, nil
+	case "x":
+		// Bulk action on marked todos (or the selected one if nothing is marked)
+		if len(m.bulkTargets()) == 0 {
+			return m, nil
+		}
+		m.bulkInput.Reset()
+		m.bulkInput.Focus()
+		m.view = "bulk_todos"
+		m.statusMsg = ""
+		return m, textarea.Blink
+	case "enter":
+		// Open todo detail (view and edit title, tags, status)
+		filtered := m.filteredDisplayTodos()
+		if m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
+			m.detailTodoID = filtered[m.selectedTodo].ID
+			m.detailField = 0
+			m.detailEditing = false
+			m.view = "todo_detail"
+			m.statusMsg = ""
+		}
+		return m, nil
+	case "A":
+		// Archive marked todos (or the selected one) right away, same as the "archive" bulk command
+		targets := m.bulkTargets()
+		if len(targets) == 0 {
+			return m, nil
+		}
+		return m, m.applyBulkAction(targets, helpers.BulkAction{Kind: "archive"})
+	case "z":
+		// Archive browser (filters are kept)
+		m.view = "archive"
+		m.selectedArchived = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadArchive()
+	case "f":
+		// Focus mode (pomodoro countdown) on the selected "next" todo
+		filtered := m.filteredDisplayTodos()
+		if m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
+			return m.startFocus(filtered[m.selectedTodo])
+		}
+		return m, nil
+	case "s":
+		// Start/stop the timer on the selected todo (stops any other running timer)
+		filtered := m.filteredDisplayTodos()
+		if m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
+			return m.toggleTimer(filtered[m.selectedTodo])
+		}
+		return m, nil
+	case "d":
+		// Delete marked todos (or the selected one) to the trash, same as the "delete" bulk command
+		targets := m.bulkTargets()
+		if len(targets) == 0 {
+			return m, nil
+		}
+		return m, m.applyBulkAction(targets, helpers.BulkAction{Kind: "delete"})
+	case "D":
+		// Trash browser (filters are kept)
+		m.view = "trash"
+		m.selectedTrashed = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadTrash()
+	case "b":
+		// Switch to board view (filters are kept)
+		m.view = "board"
+		m.boardColumn = 0
+		m.boardRows = map[string]int{}
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
 	case " ":
 		// Cycle todo status: open → next → done → open (save immediately, no re-sort)
 		// Use filtered displayTodos to keep selection stable
-		filtered := helpers.FilterTodosByDateRange(m.displayTodos, m.filterDate)
-		filtered = helpers.FilterTodosByTags(filtered, m.filterTags)
+		filtered := m.filteredDisplayTodos()
 		if m.selectedTodo >= 0 && m.selectedTodo < len(filtered) {
 			// Get the todo from filtered list (current display order)
-			todo := filtered[m.selectedTodo]
+			return m.cycleTodoStatus(filtered[m.selectedTodo])
+		}
+		return m, nil
+	}
+	return m, nil
+}
 
-			// Cycle status: open → next → done → open
-			switch todo.Status {
-			case "open":
-				todo.Status = "next"
-				m.statusMsg = "→ Next"
-			case "next":
-				todo.Status = "done"
-				m.statusMsg = "✓ Done"
-			case "done":
-				todo.Status = "open"
-				m.statusMsg = "○ Open"
-			default:
-				// Unknown status, set to open
-				todo.Status = "open"
-				m.statusMsg = "○ Open"
-			}
+// filteredDisplayTodos returns displayTodos with the current filters applied (same as the UI)
+func (m Model) filteredDisplayTodos() []models.Todo {
+	return m.todoList.Items(m.displayTodos, m.listFilter(), time.Now())
+}
 
-			// Update in m.todos array and displayTodos (find by ID)
-			// We can't update displayTodos[m.selectedTodo] directly because
-			// we're working with a filtered view
-			for i := range m.todos {
-				if m.todos[i].ID == todo.ID {
-					m.todos[i].Status = todo.Status
-					break
-				}
-			}
-			for i := range m.displayTodos {
-				if m.displayTodos[i].ID == todo.ID {
-					m.displayTodos[i].Status = todo.Status
-					break
-				}
-			}
+// cycleTodoStatus advances a todo open → next → done → open and saves it immediately (no re-sort)
+func (m Model) cycleTodoStatus(todo models.Todo) (Model, tea.Cmd) {
+	before := todo
 
-			// Save immediately and start timer to clear status
-			return m, tea.Batch(m.toggleTodoImmediate(todo), clearStatusAfterDelay())
+	// Cycle status: open → next → done → open
+	switch todo.Status {
+	case "open":
+		todo.Status = "next"
+		m.statusMsg = "→ Next"
+	case "next":
+		todo.Status = "done"
+		m.statusMsg = "✓ Done"
+	case "done":
+		todo.Status = "open"
+		m.statusMsg = "○ Open"
+	default:
+		// Unknown status, set to open
+		todo.Status = "open"
+		m.statusMsg = "○ Open"
+	}
+
+	// Track completion time for stats (cleared when reopened)
+	todo.CompletedAt = completionTime(todo.Status)
+
+	return m.saveTodoEdit(before, todo, "\""+todo.Title+"\" → "+todo.Status)
+}
+
+// saveTodoEdit applies an edited todo in memory, records it for undo (u) and saves it immediately
+func (m Model) saveTodoEdit(before, after models.Todo, label string) (Model, tea.Cmd) {
+	m = m.replaceTodo(after)
+
+	// Record for undo (u)
+	saved := after
+	m.undoLog = helpers.PushUndo(m.undoLog, helpers.TodoUndoRecord(label, &before, &saved, time.Now()))
+
+	// Save immediately and start timer to clear status
+	m.statusTime = time.Now()
+	return m, tea.Batch(m.toggleTodoImmediate(after), saveUndoLog(m.undoLog), clearStatusAfterDelay())
+}
+
+// replaceTodo updates the todo with the same ID in m.todos and m.displayTodos
+// We can't index displayTodos by selection because views work with filtered lists
+func (m Model) replaceTodo(todo models.Todo) Model {
+	for i := range m.todos {
+		if m.todos[i].ID == todo.ID {
+			m.todos[i] = todo
+			break
 		}
-		return m, nil
 	}
-	return m, nil
+	for i := range m.displayTodos {
+		if m.displayTodos[i].ID == todo.ID {
+			m.displayTodos[i] = todo
+			break
+		}
+	}
+	m.todoList.Replace(todo) // Edited in place: the cached filtered list holds a copy
+	return m
+}
+
+// completionTime returns now for "done" and nil for any other status
+func completionTime(status string) *time.Time {
+	if status != "done" {
+		return nil
+	}
+	now := time.Now()
+	return &now
 }
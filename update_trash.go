@@ -0,0 +1,96 @@
+package main
+
+import (
+	"github.com/apodacaa/amos/internal/helpers"
+	"github.com/apodacaa/amos/internal/models"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// filteredTrashedTodos returns trashedTodos with the current filters applied (same as the UI)
+func (m Model) filteredTrashedTodos() []models.Todo {
+	filtered := helpers.FilterTodosByDateRange(m.trashedTodos, m.filterDate)
+	filtered = helpers.FilterTodosByTags(filtered, m.filterTags)
+	filtered = helpers.FilterTodosByPeople(filtered, m.filterPeople)
+	return helpers.FilterTodosByText(filtered, m.filterText)
+}
+
+// handleTrashKeys processes keyboard input (trash browser)
+func (m Model) handleTrashKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "u":
+		// Undo last change (persisted, survives restart)
+		return m.handleUndo()
+	case "ctrl+r":
+		// Redo last undone change
+		return m.handleRedo()
+	case "esc":
+		m.view = "dashboard"
+		m.statusMsg = "" // Clear status message when changing views
+		return m, nil
+	case "n":
+		// Create new entry (using shared helper)
+		return m.handleNewEntry()
+	case "a":
+		// Add standalone todo (using shared helper)
+		return m.handleAddTodo()
+	case "e":
+		// Jump to entry list (explicit navigation)
+		m.view = "entries"
+		m.selectedEntry = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "t":
+		// Jump to todo list (explicit navigation)
+		m.view = "todos"
+		m.selectedTodo = 0
+		m.statusMsg = "" // Clear status message when changing views
+		return m, m.loadEntriesAndTodos()
+	case "/":
+		// Open unified filter input (or clear all filters if already filtering)
+		if len(m.filterTags) > 0 || len(m.filterPeople) > 0 || len(m.filterText) > 0 || m.filterDate != "" {
+			// Clear all filters
+			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
+			m.filterDate = ""
+			m.selectedTrashed = 0
+			m.statusMsg = ""
+			return m, nil
+		}
+		// Open unified filter (suggest tags and people from the trash too)
+		all := m.overviewTodos(append(append([]models.Todo{}, m.todos...), m.trashedTodos...))
+		m.filterContext = "trash"
+		m.availableTags = helpers.BuildTagTree(m.overviewEntries(), all)
+		m.availablePeople = helpers.ExtractUniquePeopleFromAll(m.overviewEntries(), all)
+		m.unifiedFilterInput.Reset()
+		m.unifiedFilterInput.Focus()
+		m.autocompleteTag = ""
+		m.view = "unified_filter"
+		m.statusMsg = ""
+		return m, textarea.Blink
+	case "j", "down":
+		if m.selectedTrashed < len(m.filteredTrashedTodos())-1 {
+			m.selectedTrashed++
+		}
+		return m, nil
+	case "k", "up":
+		if m.selectedTrashed > 0 {
+			m.selectedTrashed--
+		}
+		return m, nil
+	case "r":
+		// Refresh - reload trash.json
+		return m, m.loadTrash()
+	case "R":
+		// Restore selected todo to the todo list
+		filtered := m.filteredTrashedTodos()
+		if m.selectedTrashed >= 0 && m.selectedTrashed < len(filtered) {
+			return m, m.restoreTrashedTodos(map[string]bool{filtered[m.selectedTrashed].ID: true})
+		}
+		return m, nil
+	}
+	return m, nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleUndo reverts the most recent recorded action (u)
+func (m Model) handleUndo() (tea.Model, tea.Cmd) {
+	if len(m.undoLog.Undo) == 0 {
+		m.statusMsg = "nothing to undo"
+		m.statusTime = time.Now()
+		return m, clearStatusAfterDelay()
+	}
+	return m, m.applyUndo(m.undoLog.Undo[len(m.undoLog.Undo)-1], true)
+}
+
+// handleRedo replays the most recently undone action (ctrl+r)
+func (m Model) handleRedo() (tea.Model, tea.Cmd) {
+	if len(m.undoLog.Redo) == 0 {
+		m.statusMsg = "nothing to redo"
+		m.statusTime = time.Now()
+		return m, clearStatusAfterDelay()
+	}
+	return m, m.applyUndo(m.undoLog.Redo[len(m.undoLog.Redo)-1], false)
+}
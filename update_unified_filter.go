@@ -28,9 +28,11 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if currentWord != "" {
 			var match string
 
-			// Try tag match first (if starts with @)
+			// Try tag match first (if starts with @), then people (if starts with +)
 			if strings.HasPrefix(currentWord, "@") {
 				match = findBestTagMatch(currentWord, m.availableTags)
+			} else if strings.HasPrefix(currentWord, "+") {
+				match = findBestPersonMatch(currentWord, m.availablePeople)
 			} else {
 				// Try date phrase match
 				match = findBestDateMatch(currentWord)
@@ -51,6 +53,8 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if input == "" {
 			// No input, clear all filters and return to list
 			m.filterTags = []string{}
+			m.filterPeople = []string{}
+			m.filterText = []string{}
 			m.filterDate = ""
 			m.view = m.filterContext
 
@@ -59,6 +63,12 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.selectedEntry = 0
 			} else if m.filterContext == "todos" {
 				m.selectedTodo = 0
+			} else if m.filterContext == "board" {
+				m.boardRows = map[string]int{}
+			} else if m.filterContext == "archive" {
+				m.selectedArchived = 0
+			} else if m.filterContext == "trash" {
+				m.selectedTrashed = 0
 			}
 
 			return m, nil
@@ -69,6 +79,8 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		// Apply parsed filters
 		m.filterTags = result.Tags
+		m.filterPeople = result.People
+		m.filterText = result.Text
 		m.filterDate = result.Date
 
 		// Show errors if any
@@ -86,6 +98,12 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.selectedEntry = 0
 		} else if m.filterContext == "todos" {
 			m.selectedTodo = 0
+		} else if m.filterContext == "board" {
+			m.boardRows = map[string]int{}
+		} else if m.filterContext == "archive" {
+			m.selectedArchived = 0
+		} else if m.filterContext == "trash" {
+			m.selectedTrashed = 0
 		}
 
 		return m, nil
@@ -108,6 +126,13 @@ func (m Model) handleUnifiedFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			} else {
 				m.autocompleteTag = ""
 			}
+		} else if strings.HasPrefix(currentWord, "+") {
+			// Typing person (+ plus at least one character)
+			if len(currentWord) > 1 {
+				m.autocompleteTag = findBestPersonMatch(currentWord, m.availablePeople)
+			} else {
+				m.autocompleteTag = ""
+			}
 		} else {
 			// Typing date phrase
 			m.autocompleteTag = findBestDateMatch(currentWord)
@@ -137,7 +162,8 @@ func getLastWord(input string) string {
 }
 
 // findBestTagMatch finds the first tag that starts with the given prefix (case-insensitive)
-func findBestTagMatch(prefix string, availableTags []string) string {
+// Tags are in tree order, so parents are suggested before their descendants
+func findBestTagMatch(prefix string, availableTags []helpers.TagNode) string {
 	if prefix == "" {
 		return ""
 	}
@@ -146,10 +172,29 @@ func findBestTagMatch(prefix string, availableTags []string) string {
 	normalizedPrefix := strings.ToLower(strings.TrimPrefix(prefix, "@"))
 
 	// Find first matching tag
-	for _, tag := range availableTags {
-		normalizedTag := strings.ToLower(strings.TrimPrefix(tag, "@"))
+	for _, node := range availableTags {
+		normalizedTag := strings.ToLower(strings.TrimPrefix(node.Tag, "@"))
 		if strings.HasPrefix(normalizedTag, normalizedPrefix) {
-			return tag // Return with @ prefix
+			return node.Tag // Return with @ prefix
+		}
+	}
+
+	return ""
+}
+
+// findBestPersonMatch finds the first person that starts with the given prefix (case-insensitive)
+func findBestPersonMatch(prefix string, availablePeople []string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	// Normalize prefix (remove + if present, lowercase)
+	normalizedPrefix := strings.ToLower(strings.TrimPrefix(prefix, "+"))
+
+	for _, person := range availablePeople {
+		normalizedPerson := strings.ToLower(strings.TrimPrefix(person, "+"))
+		if strings.HasPrefix(normalizedPerson, normalizedPrefix) {
+			return person // Return with + prefix
 		}
 	}
 
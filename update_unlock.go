@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/apodacaa/amos/internal/storage"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleUnlockKeys processes keyboard input (passphrase prompt of an encrypted journal)
+func (m Model) handleUnlockKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		return m, tea.Quit
+	case "enter":
+		// Deriving the key takes a moment; ignore repeated presses
+		if m.unlocking {
+			return m, nil
+		}
+		passphrase := m.passphraseInput.Value()
+		if passphrase == "" {
+			m.statusMsg = "⚠ Enter the passphrase"
+			m.statusTime = time.Now()
+			return m, nil
+		}
+		m.unlocking = true
+		m.statusMsg = ""
+		return m, unlockWithPassphrase(passphrase)
+	}
+
+	if m.unlocking {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.passphraseInput, cmd = m.passphraseInput.Update(msg)
+	return m, cmd
+}
+
+// handleUnlocked opens the start view and loads the journal, or asks again after a wrong passphrase
+func (m Model) handleUnlocked(msg unlockedMsg) (tea.Model, tea.Cmd) {
+	m.unlocking = false
+	m.passphraseInput.Reset()
+
+	if msg.err != nil {
+		m.statusMsg = "⚠ " + msg.err.Error()
+		if errors.Is(msg.err, storage.ErrWrongPassphrase) {
+			m.statusMsg = "⚠ Wrong passphrase, try again"
+		}
+		m.statusTime = time.Now()
+		return m, nil
+	}
+
+	m.passphraseInput.Blur()
+	m.view = m.unlockView
+	m.statusMsg = ""
+	return m, m.loadJournal()
+}